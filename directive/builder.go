@@ -0,0 +1,142 @@
+package directive
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+const (
+	optDefault = "default="
+)
+
+// builder generates a fluent builder type with a method for each name of the given field.
+func builder(tgt *Target, opts []string) {
+	typ := strings.TrimPrefix(tgt.RcvType, "*")
+	builderNm := typ + "Builder"
+	builderRcvType := "*" + builderNm
+
+	var isRequired bool
+	var dflt string
+	for i := range opts {
+		isRequired = isRequired || opts[i] == optRequired
+		if strings.HasPrefix(opts[i], optDefault) {
+			dflt = strings.TrimPrefix(opts[i], optDefault)
+			if kindOf(tgt.FldType) == kindString {
+				dflt = strconv.Quote(dflt)
+			}
+		}
+	}
+
+	if !hasType(tgt, builderNm) {
+		vlog.V(2).Printf("Adding type: %s\n", builderNm)
+		tgt.MetaFile.Types = append(tgt.MetaFile.Types, meta.Type{
+			Name:  builderNm,
+			Embed: typ,
+			Tmpl:  "type_builder",
+		})
+	}
+
+	newNm := "New" + upperFirst(builderNm)
+	found := tgt.MetaFile.FilterMethodsN(func(m *meta.Method) bool { return m.Name == newNm }, 1)
+	var newBuilder *meta.Method
+	if len(found) > 0 {
+		newBuilder = found[0]
+	} else {
+		vlog.V(2).Printf("Adding method: %s\n", newNm)
+		newBuilder = &meta.Method{
+			Name:    newNm,
+			RetVals: builderNm,
+			FldType: typ,
+			Misc:    map[string]interface{}{"Defaults": ""},
+			Tmpl:    "builder_new",
+		}
+		tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, newBuilder)
+	}
+
+	elemType := strings.TrimPrefix(tgt.FldType, "[]")
+	argType := tgt.FldType
+	if kindOf(tgt.FldType) == kindSlice {
+		argType = "..." + elemType
+	}
+	arg := argName(tgt.RcvName, elemType)
+
+	for _, fldNm := range tgt.FldNames {
+		method := upperFirst(fldNm)
+
+		vlog.V(2).Printf("Adding method: %s\n", method)
+		setter := meta.Method{
+			RcvName: tgt.RcvName,
+			RcvType: builderRcvType,
+			Name:    method,
+			ArgName: arg,
+			ArgType: argType,
+			FldName: fldNm,
+			Tmpl:    "builder_field",
+		}
+		tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &setter)
+
+		if dflt != "" {
+			defaults := newBuilder.Misc["Defaults"].(string)
+			if defaults != "" {
+				defaults += "\n\t\t\t"
+			}
+			newBuilder.Misc["Defaults"] = fmt.Sprintf("%s%s: %s,", defaults, fldNm, dflt)
+		}
+	}
+
+	vlog.V(2).Printf("Adding to method: Build\n")
+	found = tgt.MetaFile.FilterMethodsN(
+		func(m *meta.Method) bool { return m.RcvType == builderRcvType && m.Name == "Build" },
+		1,
+	)
+	var build *meta.Method
+	if len(found) > 0 {
+		build = found[0]
+	} else {
+		build = &meta.Method{
+			RcvName: tgt.RcvName,
+			RcvType: builderRcvType,
+			Name:    "Build",
+			RetVals: tgt.RcvType,
+			FldType: typ,
+			Misc: map[string]interface{}{
+				"Checks": "",
+				"Ptr":    strings.HasPrefix(tgt.RcvType, "*"),
+			},
+			Tmpl: "builder_build",
+		}
+		tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, build)
+	}
+	if !isRequired {
+		return
+	}
+
+	vlog.V(2).Printf("Adding import: \"fmt\"\n")
+	tgt.MetaFile.Imports["fmt"] = struct{}{}
+	vlog.V(2).Printf("Adding import: \"strings\"\n")
+	tgt.MetaFile.Imports["strings"] = struct{}{}
+	for _, fldNm := range tgt.FldNames {
+		checks := build.Misc["Checks"].(string)
+		if checks != "" {
+			checks += "\n\t"
+		}
+		build.Misc["Checks"] = fmt.Sprintf(
+			"%sif !%s.set[%q] {\n\t\tmissing = append(missing, %q)\n\t}",
+			checks, tgt.RcvName, fldNm, fldNm,
+		)
+	}
+}
+
+// hasType answers whether the named type has already been added to the target file.
+func hasType(tgt *Target, name string) bool {
+	for i := range tgt.MetaFile.Types {
+		if tgt.MetaFile.Types[i].Name == name {
+			return true
+		}
+	}
+	return false
+}
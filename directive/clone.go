@@ -0,0 +1,144 @@
+package directive
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+const (
+	optShallow = "shallow"
+	optSkip    = "skip"
+)
+
+// clone adds each name of the given field to the Clone() implementation.
+func clone(tgt *Target, opts []string) {
+	var isShallow, isSkip bool
+	for i := range opts {
+		isShallow = isShallow || opts[i] == optShallow
+		isSkip = isSkip || opts[i] == optSkip
+	}
+
+	for _, fldNm := range tgt.FldNames {
+		vlog.V(2).Printf("Adding to method: Clone\n")
+		found := tgt.MetaFile.FilterMethodsN(
+			func(m *meta.Method) bool {
+				return m.RcvName == tgt.RcvName && m.RcvType == tgt.RcvType && m.Name == "Clone"
+			},
+			1,
+		)
+		var clone *meta.Method
+		if len(found) > 0 {
+			clone = found[0]
+		} else {
+			clone = &meta.Method{
+				RcvName: tgt.RcvName,
+				RcvType: tgt.RcvType,
+				Name:    "Clone",
+				RetVals: tgt.RcvType,
+				Misc: map[string]interface{}{
+					"Copies": "",
+					"Ptr":    strings.HasPrefix(tgt.RcvType, "*"),
+				},
+				Tmpl: "clone",
+			}
+			tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, clone)
+		}
+
+		// the receiver has already been copied, so only references need attention
+		var stmts string
+		switch {
+		case isSkip:
+			stmts = fmt.Sprintf("%s2.%s = %s", tgt.RcvName, fldNm, zeroValue(tgt.FldType))
+		case isShallow:
+			continue
+		default:
+			stmts = cloneStmts(tgt, tgt.RcvName+"2."+fldNm, tgt.RcvName+"."+fldNm, tgt.FldType, 0)
+		}
+		if stmts == "" {
+			continue
+		}
+		clone.Misc["Copies"] = joinNonEmpty(clone.Misc["Copies"].(string), "\n\t", strings.Replace(stmts, "\n", "\n\t", -1))
+	}
+}
+
+// cloneStmts returns statements that make dst, which already holds a shallow copy of src, a deep copy.
+// Returns "" if the shallow copy suffices.
+// Values whose type has a Clone method that returns the same type (or a pointer to it) are copied using that method.
+func cloneStmts(tgt *Target, dst, src, typ string, depth int) string {
+	i, k, v, c := localName(tgt, "i", depth), localName(tgt, "k", depth), localName(tgt, "v", depth), localName(tgt, "c", depth)
+
+	elem := elemOf(typ)
+	switch kindOf(typ) {
+	case kindSlice:
+		code := fmt.Sprintf("if %s != nil {\n\t%s = make(%s, len(%s))\n\tcopy(%s, %s)\n", src, dst, typ, src, dst, src)
+		if elemStmts := cloneStmts(tgt, dst+"["+i+"]", src+"["+i+"]", elem, depth+1); elemStmts != "" {
+			code += fmt.Sprintf("\tfor %s := range %s {\n%s\n\t}\n", i, src, indent(elemStmts, 2))
+		}
+		return code + "}"
+	case kindArray:
+		if elemStmts := cloneStmts(tgt, dst+"["+i+"]", src+"["+i+"]", elem, depth+1); elemStmts != "" {
+			return fmt.Sprintf("for %s := range %s {\n%s\n}", i, src, indent(elemStmts, 1))
+		}
+	case kindMap:
+		code := fmt.Sprintf("if %s != nil {\n\t%s = make(%s, len(%s))\n\tfor %s, %s := range %s {\n", src, dst, typ, src, k, v, src)
+		if elemStmts := cloneStmts(tgt, c, v, elem, depth+1); elemStmts != "" {
+			code += fmt.Sprintf("\t\t%s := %s\n%s\n\t\t%s[%s] = %s\n", c, v, indent(elemStmts, 2), dst, k, c)
+		} else {
+			code += fmt.Sprintf("\t\t%s[%s] = %s\n", dst, k, v)
+		}
+		return code + "\t}\n}"
+	case kindPtr:
+		deref := "*" + src
+		if k := kindOf(elem); k == kindSlice || k == kindArray || k == kindMap || k == kindOther {
+			deref = "(" + deref + ")"
+		}
+		code := fmt.Sprintf("if %s != nil {\n\t%s := *%s\n", src, c, src)
+		if elemStmts := cloneStmts(tgt, c, deref, elem, depth+1); elemStmts != "" {
+			code += indent(elemStmts, 1) + "\n"
+		}
+		return code + fmt.Sprintf("\t%s = &%s\n}", dst, c)
+	case kindOther:
+		t := lookupType(tgt, typ)
+		if t == nil {
+			tgt.warnf("skipping 'clone' - unknown type %s", typ)
+			return ""
+		}
+		if sig := methodOf(tgt, t, "Clone"); sig != nil && sig.Params().Len() == 0 && sig.Results().Len() == 1 {
+			switch res := sig.Results().At(0).Type(); {
+			case types.Identical(res, t):
+				return fmt.Sprintf("%s = %s.Clone()", dst, src)
+			case types.Identical(res, types.NewPointer(t)):
+				return fmt.Sprintf("%s = *%s.Clone()", dst, src)
+			}
+		}
+		if ok, isPtr := generatesMethod(tgt, t, "clone"); ok {
+			if isPtr {
+				return fmt.Sprintf("%s = *%s.Clone()", dst, src)
+			}
+			return fmt.Sprintf("%s = %s.Clone()", dst, src)
+		}
+	}
+	return ""
+}
+
+// indent prefixes every line of code with the given number of tabs.
+func indent(code string, tabs int) string {
+	prefix := strings.Repeat("\t", tabs)
+	return prefix + strings.Replace(code, "\n", "\n"+prefix, -1)
+}
+
+// localName returns the name of a local variable of generated code at the given depth of nesting
+// (e.g. "i" or "i1"), which never equals the name of the receiver or the name of its counterpart (e.g. "i2").
+func localName(tgt *Target, name string, depth int) string {
+	if depth > 0 {
+		name += fmt.Sprint(depth)
+	}
+	for name == tgt.RcvName || name == tgt.RcvName+"2" {
+		name += "_"
+	}
+	return name
+}
@@ -0,0 +1,165 @@
+package directive
+
+import (
+	"fmt"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+const (
+	optAsc  = "asc"
+	optDesc = "desc"
+)
+
+// compareField is a field of the generated Compare method.
+type compareField struct {
+	// priority is the position of the field in the ordering, 0 if unspecified
+	priority int
+	cmps     string
+}
+
+// compare adds each name of the given field to the Compare() implementation.
+// Fields with a priority are compared first, in ascending order of priority,
+// followed by the remaining fields in the order of declaration.
+func compare(tgt *Target, opts []string) {
+	var priority int
+	var isDesc bool
+	for i := range opts {
+		if n, err := strconv.Atoi(opts[i]); err == nil && n > 0 {
+			priority = n
+		}
+		switch opts[i] {
+		case optAsc:
+			isDesc = false
+		case optDesc:
+			isDesc = true
+		}
+	}
+
+	for _, fldNm := range tgt.FldNames {
+		vlog.V(2).Printf("Adding to method: Compare\n")
+		found := tgt.MetaFile.FilterMethodsN(
+			func(m *meta.Method) bool {
+				return m.RcvName == tgt.RcvName && m.RcvType == tgt.RcvType && m.Name == "Compare"
+			},
+			1,
+		)
+		var cmp *meta.Method
+		if len(found) > 0 {
+			cmp = found[0]
+		} else {
+			cmp = &meta.Method{
+				RcvName: tgt.RcvName,
+				RcvType: tgt.RcvType,
+				Name:    "Compare",
+				RetVals: "int",
+				Misc: map[string]interface{}{
+					"Fields": []compareField{},
+					"Ptr":    strings.HasPrefix(tgt.RcvType, "*"),
+				},
+				Tmpl: "compare",
+			}
+			tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, cmp)
+		}
+
+		a, b := tgt.RcvName+"."+fldNm, tgt.RcvName+"2."+fldNm
+		stmts, ok := compareStmts(tgt, a, b, tgt.FldType, isDesc, 0)
+		if !ok {
+			tgt.warnf("skipping 'compare' of %s - type %s is not ordered and has no Compare method", fldNm, tgt.FldType)
+			continue
+		}
+
+		flds := cmp.Misc["Fields"].([]compareField)
+		pos := len(flds)
+		if priority > 0 {
+			pos = 0
+			for pos < len(flds) && flds[pos].priority > 0 && flds[pos].priority <= priority {
+				pos++
+			}
+		}
+		flds = append(flds, compareField{})
+		copy(flds[pos+1:], flds[pos:])
+		flds[pos] = compareField{
+			priority: priority,
+			cmps:     strings.Replace(stmts, "\n", "\n\t", -1),
+		}
+		cmps := make([]string, len(flds))
+		for i := range flds {
+			cmps[i] = flds[i].cmps
+		}
+		cmp.Misc["Fields"] = flds
+		cmp.Misc["Cmps"] = strings.Join(cmps, "\n\t")
+	}
+}
+
+// compareStmts returns statements that return the result of comparing a and b,
+// which are of type typ, if they are not equal.
+// It returns false if values of the type cannot be ordered.
+func compareStmts(tgt *Target, a, b, typ string, desc bool, depth int) (string, bool) {
+	lt, gt := "-1", "+1"
+	if desc {
+		lt, gt = gt, lt
+	}
+	ordered := func(lessA, lessB string) string {
+		return fmt.Sprintf("switch {\ncase %s:\n\treturn %s\ncase %s:\n\treturn %s\n}", lessA, lt, lessB, gt)
+	}
+
+	i, n := localName(tgt, "i", depth), localName(tgt, "n", depth)
+
+	elem := elemOf(typ)
+	k := kindOf(typ)
+	if k == kindOther {
+		t := lookupType(tgt, typ)
+		if t == nil {
+			return "", false
+		}
+		if call := binaryCall(tgt, t, "Compare", "compare", types.Int, a, b); call != "" {
+			ret := n
+			if desc {
+				ret = "-" + n
+			}
+			return fmt.Sprintf("if %s := %s; %s != 0 {\n\treturn %s\n}", n, call, n, ret), true
+		}
+		// named string, numeric and boolean types are ordered like their underlying type
+		k = underlyingKind(t)
+	}
+	switch k {
+	case kindString, kindInt:
+		return ordered(a+" < "+b, a+" > "+b), true
+	case kindFloat:
+		// NaN is less than any other value, like cmp.Compare
+		return ordered(
+			fmt.Sprintf("%[1]s != %[1]s && %[2]s == %[2]s, %[1]s < %[2]s", a, b),
+			fmt.Sprintf("%[1]s == %[1]s && %[2]s != %[2]s, %[1]s > %[2]s", a, b),
+		), true
+	case kindBool:
+		return ordered("!"+a+" && "+b, a+" && !"+b), true
+	case kindSlice, kindArray:
+		// lexicographic, like bytes.Compare
+		stmts, ok := compareStmts(tgt, a+"["+i+"]", b+"["+i+"]", elem, desc, depth+1)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("for %[1]s := 0; %[1]s < len(%[2]s) && %[1]s < len(%[3]s); %[1]s++ {\n%[4]s\n}\n", i, a, b, indent(stmts, 1)) +
+			ordered("len("+a+") < len("+b+")", "len("+a+") > len("+b+")"), true
+	case kindPtr:
+		deref := func(x string) string {
+			if k := kindOf(elem); k == kindSlice || k == kindArray || k == kindMap || k == kindOther {
+				return "(*" + x + ")"
+			}
+			return "*" + x
+		}
+		stmts, ok := compareStmts(tgt, deref(a), deref(b), elem, desc, depth+1)
+		if !ok {
+			return "", false
+		}
+		// nil is less than non-nil
+		return ordered(a+" == nil && "+b+" != nil", a+" != nil && "+b+" == nil") +
+			fmt.Sprintf("\nif %s != nil && %s != nil {\n%s\n}", a, b, indent(stmts, 1)), true
+	}
+	return "", false
+}
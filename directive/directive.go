@@ -2,10 +2,11 @@ package directive
 
 import (
 	"fmt"
-	"log"
+	"go/types"
 	"strings"
 	"unicode/utf8"
 
+	"github.com/phelmkamp/metatag/internal/vlog"
 	"github.com/phelmkamp/metatag/meta"
 )
 
@@ -15,19 +16,52 @@ const (
 	optFunc      = "func"
 	optReflect   = "reflect"
 	optChain     = "chain"
+	optTyped     = "typed"
+	optEpsilon   = "epsilon="
+	optCompare   = "compare"
+	optNatural   = "natural"
+	optBy        = "by="
+	optStable    = "stable"
+	optInPlace   = "inplace"
 )
 
 var (
 	runFuncs = map[string]runFunc{
-		"ptr":      ptr,
-		"getter":   getter,
-		"setter":   setter,
-		"filter":   filter,
-		"mapper":   mapper,
-		"sort":     sort,
-		"stringer": stringer,
-		"new":      runNew,
-		"equal":    equal,
+		"ptr":       ptr,
+		"getter":    getter,
+		"setter":    setter,
+		"filter":    filter,
+		"mapper":    mapper,
+		"sort":      sort,
+		"stringer":  stringer,
+		"new":       runNew,
+		"equal":     equal,
+		"validate":  validate,
+		"builder":   builder,
+		"option":    option,
+		"clone":     clone,
+		"hash":      hash,
+		"compare":   compare,
+		"search":    search,
+		"heap":      heap,
+		"any":       runAny,
+		"all":       all,
+		"count":     count,
+		"find":      find,
+		"index":     index,
+		"contains":  contains,
+		"reduce":    reduce,
+		"groupby":   groupby,
+		"distinct":  distinct,
+		"partition": partition,
+		"chunk":     chunk,
+		"iter":      iter,
+		"lookup":    lookup,
+		"put":       put,
+		"delete":    runDelete,
+		"keys":      keys,
+		"values":    values,
+		"elems":     elems,
 	}
 )
 
@@ -37,13 +71,22 @@ type Target struct {
 	RcvName, RcvType string
 	FldNames         []string
 	FldType          string
-	DfltOpts         []string
+	// Directives are all directives of the field, including their options
+	Directives []string
+	DfltOpts   []string
+	// Pos is the position of the tagged field (e.g. "foo.go:12"), if provenance is desired
+	Pos string
+	// Diagnostics are the problems encountered while running directives
+	Diagnostics []string
+	// Pkg is the package of the target with type information, if available
+	Pkg *types.Package
 }
 
 type runFunc func(*Target, []string)
 
 // RunAll runs all of the given directives.
 func RunAll(ds []string, tgt *Target) {
+	tgt.Directives = ds
 	for i := range ds {
 		Run(ds[i], tgt)
 	}
@@ -58,6 +101,7 @@ func Run(d string, tgt *Target) {
 		return
 	}
 
+	origin := tgt.Pos + " " + d
 	opts := strings.Split(d, ",")
 	d = opts[0]
 	opts = opts[1:]
@@ -65,17 +109,32 @@ func Run(d string, tgt *Target) {
 
 	run, ok := runFuncs[d]
 	if !ok {
-		log.Printf("Unknown directive: %s\n", d)
+		tgt.warnf("Unknown directive: %s", d)
 		return
 	}
 
+	if tgt.Pos == "" {
+		run(tgt, opts)
+		return
+	}
+
+	// some directives add to methods of earlier fields (e.g. Equal), so remember how they looked
+	before := make(map[*meta.Method]string, len(tgt.MetaFile.Methods))
+	for _, m := range tgt.MetaFile.Methods {
+		before[m] = fmt.Sprint(*m)
+	}
 	run(tgt, opts)
+	for _, m := range tgt.MetaFile.Methods {
+		if s, ok := before[m]; !ok || s != fmt.Sprint(*m) {
+			m.Origins = append(m.Origins, origin)
+		}
+	}
 }
 
 // ptr converts the receiver to a pointer for all subsequent directives.
 func ptr(tgt *Target, opts []string) {
 	tgt.RcvType = "*" + tgt.RcvType
-	log.Printf("Using pointer receiver: %s\n", tgt.RcvType)
+	vlog.V(2).Printf("Using pointer receiver: %s\n", tgt.RcvType)
 }
 
 // getter generates a getter method for each name of the given field.
@@ -86,7 +145,7 @@ func getter(tgt *Target, opts []string) {
 			method = "Get" + method
 		}
 
-		log.Printf("Adding method: %s\n", method)
+		vlog.V(2).Printf("Adding method: %s\n", method)
 		getter := meta.Method{
 			RcvName: tgt.RcvName,
 			RcvType: tgt.RcvType,
@@ -113,7 +172,7 @@ func setter(tgt *Target, opts []string) {
 	for _, fldNm := range tgt.FldNames {
 		method := "Set" + upperFirst(fldNm)
 
-		log.Printf("Adding method: %s\n", method)
+		vlog.V(2).Printf("Adding method: %s\n", method)
 		setter := meta.Method{
 			RcvName: tgt.RcvName,
 			RcvType: ptrRcvType,
@@ -130,11 +189,32 @@ func setter(tgt *Target, opts []string) {
 // filter generates a filter method for each name of the given field.
 func filter(tgt *Target, opts []string) {
 	elemType := strings.TrimPrefix(tgt.FldType, "[]")
+	if t := lookupType(tgt, tgt.FldType); kindOf(tgt.FldType) == kindOther && t != nil {
+		// named slice type
+		if s, ok := t.Underlying().(*types.Slice); ok {
+			elemType = typeString(tgt, s.Elem())
+		}
+	}
 
-	var isOmitField, isChain bool
+	var isOmitField, isChain, isInPlace bool
 	for i := range opts {
 		isOmitField = isOmitField || opts[i] == optOmitField
 		isChain = isChain || opts[i] == optChain
+		isInPlace = isInPlace || opts[i] == optInPlace
+	}
+	call := parseCallOpts(tgt, opts)
+	if kindOf(tgt.FldType) == kindMap {
+		filterMap(tgt, call, isOmitField, isChain, isInPlace)
+		return
+	}
+	if isInPlace && (call.parallel || call.fallible()) {
+		tgt.warnf("ignoring '%s' - not supported with '%s', '%s' or '%s'", optInPlace, optParallel, optCtx, optErr)
+		isInPlace = false
+	}
+
+	ptrRcvType := tgt.RcvType
+	if !strings.HasPrefix(tgt.RcvType, "*") {
+		ptrRcvType = "*" + tgt.RcvType
 	}
 
 	for _, fldNm := range tgt.FldNames {
@@ -144,14 +224,47 @@ func filter(tgt *Target, opts []string) {
 			method += upperFirst(fldNm)
 		}
 
+		if isInPlace {
+			filterInPlace(tgt, fldNm, method, elemType, ptrRcvType, isOmitField, isChain)
+			continue
+		}
+
 		retVals, retStmt := tgt.FldType, "return result"
 		if isChain {
 			retVals = tgt.RcvType
 			retStmt = fmt.Sprintf("%s.%s = result\n\treturn %s", tgt.RcvName, fldNm, tgt.RcvName)
 		}
 
-		log.Printf("Adding method: %s\n", method)
-		log.Printf("Adding method: %sN\n", method)
+		if call.parallel || call.fallible() {
+			misc := call.misc(tgt)
+			misc["Chain"] = isChain
+			misc["Zero"] = "nil"
+			if isChain {
+				misc["Zero"] = tgt.RcvName
+			}
+			tmpl := "filter_err"
+			if call.parallel {
+				tmpl = "filter_parallel"
+			}
+
+			vlog.V(2).Printf("Adding method: %s\n", method)
+			filter := meta.Method{
+				RcvName: tgt.RcvName,
+				RcvType: tgt.RcvType,
+				Name:    method,
+				ArgType: elemType,
+				RetVals: retVals,
+				FldName: fldNm,
+				FldType: tgt.FldType,
+				Misc:    misc,
+				Tmpl:    tmpl,
+			}
+			tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &filter)
+			continue
+		}
+
+		vlog.V(2).Printf("Adding method: %s\n", method)
+		vlog.V(2).Printf("Adding method: %sN\n", method)
 		filter := meta.Method{
 			RcvName: tgt.RcvName,
 			RcvType: tgt.RcvType,
@@ -167,10 +280,52 @@ func filter(tgt *Target, opts []string) {
 	}
 }
 
+// filterInPlace generates filter, retain and reject methods that compact the given field in place.
+func filterInPlace(tgt *Target, fldNm, method, elemType, ptrRcvType string, isOmitField, isChain bool) {
+	// the field is replaced, so the receiver must be a pointer unless the result is chained
+	rcvType, retVals := ptrRcvType, tgt.FldType
+	if isChain {
+		rcvType, retVals = tgt.RcvType, tgt.RcvType
+	}
+
+	vlog.V(2).Printf("Adding method: %s\n", method)
+	vlog.V(2).Printf("Adding method: %sN\n", method)
+	filter := meta.Method{
+		RcvName: tgt.RcvName,
+		RcvType: rcvType,
+		Name:    method,
+		ArgType: elemType,
+		RetVals: retVals,
+		FldName: fldNm,
+		Misc:    map[string]interface{}{"Chain": isChain},
+		Tmpl:    "filter_inplace",
+	}
+	tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &filter)
+
+	retain, reject := "Retain", "Reject"
+	if !isOmitField {
+		retain += upperFirst(fldNm)
+		reject += upperFirst(fldNm)
+	}
+
+	vlog.V(2).Printf("Adding method: %s\n", retain)
+	vlog.V(2).Printf("Adding method: %s\n", reject)
+	retainer := meta.Method{
+		RcvName: tgt.RcvName,
+		RcvType: ptrRcvType,
+		Name:    retain,
+		ArgType: elemType,
+		FldName: fldNm,
+		Misc:    map[string]interface{}{"Reject": reject},
+		Tmpl:    "retain",
+	}
+	tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &retainer)
+}
+
 // mapper generates a mapper method for each name of the given field.
 func mapper(tgt *Target, opts []string) {
 	if len(opts) < 1 {
-		log.Print("skipping 'mapper' - must specify target type as first option\n")
+		tgt.warnf("skipping 'mapper' - must specify target type as first option")
 		return
 	}
 
@@ -191,6 +346,11 @@ func mapper(tgt *Target, opts []string) {
 			break
 		}
 	}
+	call := parseCallOpts(tgt, opts)
+	if kindOf(tgt.FldType) == kindMap {
+		mapperMap(tgt, call, result, isOmitField)
+		return
+	}
 
 	for _, fldNm := range tgt.FldNames {
 		var fldPart string
@@ -199,7 +359,29 @@ func mapper(tgt *Target, opts []string) {
 		}
 		method := fmt.Sprintf("Map%sTo%s", fldPart, upperFirst(sel))
 
-		log.Printf("Adding method: %s\n", method)
+		if call.parallel || call.fallible() {
+			misc := call.misc(tgt)
+			misc["Result"] = result
+			tmpl := "mapper_err"
+			if call.parallel {
+				tmpl = "mapper_parallel"
+			}
+
+			vlog.V(2).Printf("Adding method: %s\n", method)
+			mapper := meta.Method{
+				RcvName: tgt.RcvName,
+				RcvType: tgt.RcvType,
+				Name:    method,
+				ArgType: elemType,
+				FldName: fldNm,
+				Misc:    misc,
+				Tmpl:    tmpl,
+			}
+			tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &mapper)
+			continue
+		}
+
+		vlog.V(2).Printf("Adding method: %s\n", method)
 		mapper := meta.Method{
 			RcvName: tgt.RcvName,
 			RcvType: tgt.RcvType,
@@ -215,13 +397,45 @@ func mapper(tgt *Target, opts []string) {
 
 // sort generates sort methods for the first name of the given field.
 func sort(tgt *Target, opts []string) {
-	log.Print("Adding import: \"sort\"\n")
-	tgt.MetaFile.Imports["sort"] = struct{}{}
-
 	fldNm := tgt.FldNames[0]
+	elemType := strings.TrimPrefix(tgt.FldType, "[]")
+
+	var order, by string
+	var isStable, isDesc bool
+	for i := range opts {
+		switch {
+		case opts[i] == optFunc, opts[i] == optStringer, opts[i] == optCompare, opts[i] == optNatural:
+			order = opts[i]
+		case strings.HasPrefix(opts[i], optBy):
+			order, by = optBy, strings.TrimPrefix(opts[i], optBy)
+		case opts[i] == optStable:
+			isStable = true
+		case opts[i] == optDesc:
+			isDesc = true
+		}
+	}
+
+	// operands of the less function, swapped for descending order
+	a, b := tgt.RcvName+"."+fldNm+"[i]", tgt.RcvName+"."+fldNm+"[j]"
+	if isDesc {
+		a, b = b, a
+	}
+
+	// without a Less method, the remaining methods would not compile
+	var lessStmt string
+	if order != "" && order != optFunc {
+		expr := lessExpr(tgt, order, by, elemType, a, b)
+		if expr == "" {
+			return
+		}
+		lessStmt = "return " + expr
+	}
+
+	vlog.V(2).Printf("Adding import: \"sort\"\n")
+	tgt.MetaFile.Imports["sort"] = struct{}{}
 
-	log.Println("Adding method: Len")
-	log.Println("Adding method: Swap")
+	vlog.V(2).Printf("Adding method: Len\n")
+	vlog.V(2).Printf("Adding method: Swap\n")
 	lenSwap := meta.Method{
 		RcvName: tgt.RcvName,
 		RcvType: tgt.RcvType,
@@ -230,23 +444,10 @@ func sort(tgt *Target, opts []string) {
 	}
 	tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &lenSwap)
 
-	var isStringer, isFunc bool
-	for i := range opts {
-		if opts[i] == optFunc {
-			isFunc = true
-			break
-		}
-		if opts[i] == optStringer {
-			isStringer = true
-			break
-		}
-	}
-
-	if isFunc {
-		elemType := strings.TrimPrefix(tgt.FldType, "[]")
+	if order == optFunc {
 		lesserNm := lowerFirst(tgt.RcvType) + "Lesser"
 
-		log.Println("Adding type: " + lesserNm)
+		vlog.V(2).Printf("Adding type: %s\n", lesserNm)
 		lesser := meta.Type{
 			Name:  lesserNm,
 			Embed: tgt.RcvType,
@@ -257,28 +458,30 @@ func sort(tgt *Target, opts []string) {
 		}
 		tgt.MetaFile.Types = append(tgt.MetaFile.Types, lesser)
 
-		log.Println("Adding method: Less")
+		vlog.V(2).Printf("Adding method: Less\n")
 		less := meta.Method{
 			RcvName: tgt.RcvName,
 			RcvType: lesserNm,
 			FldName: fldNm,
 			Misc: map[string]interface{}{
-				"RetStmt": fmt.Sprintf(
-					"return %s.less(%s.%s[i], %s.%s[j])",
-					tgt.RcvName, tgt.RcvName, fldNm, tgt.RcvName, fldNm,
-				),
+				"RetStmt": fmt.Sprintf("return %s.less(%s, %s)", tgt.RcvName, a, b),
 			},
 			Tmpl: "less",
 		}
 		tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &less)
 
-		log.Println("Adding method: Sort")
+		vlog.V(2).Printf("Adding method: Sort\n")
 		sort := meta.Method{
 			RcvName: tgt.RcvName,
 			RcvType: tgt.RcvType,
+			Name:    "Sort",
 			ArgType: elemType,
+			FldName: fldNm,
 			Misc: map[string]interface{}{
 				"Lesser": lesserNm,
+				"Stable": isStable,
+				"Order":  order,
+				"Desc":   isDesc,
 			},
 			Tmpl: "sort_func",
 		}
@@ -286,41 +489,95 @@ func sort(tgt *Target, opts []string) {
 		return
 	}
 
-	if isStringer {
-		log.Println("Adding method: Less")
+	if lessStmt != "" {
+		vlog.V(2).Printf("Adding method: Less\n")
 		less := meta.Method{
 			RcvName: tgt.RcvName,
 			RcvType: tgt.RcvType,
 			FldName: fldNm,
 			Misc: map[string]interface{}{
-				"RetStmt": fmt.Sprintf(
-					"return %s.%s[i].String() < %s.%s[j].String()",
-					tgt.RcvName, fldNm, tgt.RcvName, fldNm,
-				),
+				"RetStmt": lessStmt,
 			},
 			Tmpl: "less",
 		}
 		tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &less)
 	}
 
-	log.Println("Adding method: Sort")
+	vlog.V(2).Printf("Adding method: Sort\n")
 	sort := meta.Method{
 		RcvName: tgt.RcvName,
 		RcvType: tgt.RcvType,
+		Name:    "Sort",
 		FldName: fldNm,
-		Tmpl:    "sort",
+		Misc: map[string]interface{}{
+			"Stable": isStable,
+			"Order":  order,
+			"By":     by,
+			"Desc":   isDesc,
+		},
+		Tmpl: "sort",
 	}
 	tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &sort)
 }
 
+// lessExpr returns an expression that answers whether element a, which is of type elemType, should sort before element b.
+// It returns "" if elements cannot be ordered as specified.
+func lessExpr(tgt *Target, order, by, elemType, a, b string) string {
+	switch order {
+	case optFunc:
+		return "less(" + a + ", " + b + ")"
+	case optStringer:
+		return a + ".String() < " + b + ".String()"
+	case optCompare:
+		return a + ".Compare(" + b + ") < 0"
+	case optNatural:
+		k := kindOf(elemType)
+		if t := lookupType(tgt, elemType); k == kindOther && t != nil {
+			k = underlyingKind(t)
+		}
+		switch k {
+		case kindString, kindInt:
+			return a + " < " + b
+		case kindFloat:
+			// NaN sorts first, like sort.Float64s
+			return fmt.Sprintf("%[1]s < %[2]s || (%[1]s != %[1]s && %[2]s == %[2]s)", a, b)
+		}
+		tgt.warnf("skipping 'sort,%s' - elements of type %s are not ordered", optNatural, elemType)
+		return ""
+	case optBy:
+		t := lookupType(tgt, elemType)
+		if t == nil {
+			tgt.warnf("skipping 'sort,%s%s' - unknown type %s", optBy, by, elemType)
+			return ""
+		}
+		ft := fieldType(tgt, t, by)
+		if ft == nil {
+			tgt.warnf("skipping 'sort,%s%s' - type %s has no field %s", optBy, by, elemType, by)
+			return ""
+		}
+		a, b = a+"."+by, b+"."+by
+		if call := binaryCall(tgt, ft, "Compare", "compare", types.Int, a, b); call != "" {
+			return call + " < 0"
+		}
+		switch {
+		case hasBinaryMethod(tgt, ft, "Before", types.Bool):
+			return a + ".Before(" + b + ")"
+		case isOrdered(ft):
+			return a + " < " + b
+		}
+		tgt.warnf("skipping 'sort,%s%s' - field of type %s is not ordered", optBy, by, ft)
+	}
+	return ""
+}
+
 // stringer adds each name of the given field to the String() implementation.
 func stringer(tgt *Target, opts []string) {
-	log.Print("Adding import: \"fmt\"\n")
+	vlog.V(2).Printf("Adding import: \"fmt\"\n")
 	tgt.MetaFile.Imports["fmt"] = struct{}{}
 
 	for _, fldNm := range tgt.FldNames {
-		log.Print("Adding to method: String\n")
-		found := tgt.MetaFile.FilterMethods(
+		vlog.V(2).Printf("Adding to method: String\n")
+		found := tgt.MetaFile.FilterMethodsN(
 			func(m *meta.Method) bool {
 				return m.RcvName == tgt.RcvName && m.RcvType == tgt.RcvType && m.Name == "String"
 			},
@@ -350,38 +607,65 @@ func stringer(tgt *Target, opts []string) {
 
 // runNew adds each name of the given field to the New() implementation.
 func runNew(tgt *Target, opts []string) {
-	method := "New" + upperFirst(tgt.RcvType)
 	for _, fldNm := range tgt.FldNames {
-		log.Printf("Adding to method: %s\n", method)
-		found := tgt.MetaFile.FilterMethods(func(m *meta.Method) bool { return m.Name == method }, 1)
-		var args, fields string
-		var new *meta.Method
-		if len(found) > 0 {
-			new = found[0]
-			args = new.Misc["Args"].(string) + ", "
-			fields = new.Misc["Fields"].(string) + "\n\t\t"
-		} else {
-			new = &meta.Method{
-				RcvType: tgt.RcvType,
-				Name:    method,
-				RetVals: tgt.RcvType,
-				Misc:    make(map[string]interface{}),
-				Tmpl:    "new",
-			}
-			tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, new)
-		}
+		new := newMethod(tgt)
+		vlog.V(2).Printf("Adding to method: %s\n", new.Name)
 
 		arg := lowerFirst(fldNm)
-		new.Misc["Args"] = fmt.Sprintf("%s%s %s", args, arg, tgt.FldType)
-		new.Misc["Fields"] = fmt.Sprintf("%s%s: %s", fields, fldNm, arg) + ","
+		new.Misc["Args"] = joinNonEmpty(new.Misc["Args"].(string), ", ", fmt.Sprintf("%s %s", arg, tgt.FldType))
+		new.Misc["Fields"] = joinNonEmpty(new.Misc["Fields"].(string), "\n\t\t", fmt.Sprintf("%s: %s,", fldNm, arg))
+	}
+}
+
+// newMethod returns the New() implementation for the target type, adding it if necessary.
+func newMethod(tgt *Target) *meta.Method {
+	typ := strings.TrimPrefix(tgt.RcvType, "*")
+	method := "New" + upperFirst(typ)
+	found := tgt.MetaFile.FilterMethodsN(func(m *meta.Method) bool { return m.Name == method }, 1)
+	if len(found) > 0 {
+		return found[0]
 	}
+
+	new := &meta.Method{
+		RcvName: tgt.RcvName,
+		RcvType: typ,
+		Name:    method,
+		RetVals: typ,
+		Misc: map[string]interface{}{
+			"Args":   "",
+			"Fields": "",
+		},
+		Tmpl: "new",
+	}
+	tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, new)
+	return new
 }
 
 // equal adds each name of the given field to the Equal() implementation.
 func equal(tgt *Target, opts []string) {
+	var isReflect, isTyped bool
+	var epsilon string
+	for i := range opts {
+		isReflect = isReflect || opts[i] == optReflect
+		isTyped = isTyped || opts[i] == optTyped
+		if strings.HasPrefix(opts[i], optEpsilon) {
+			epsilon = strings.TrimPrefix(opts[i], optEpsilon)
+		}
+	}
+
 	for _, fldNm := range tgt.FldNames {
-		log.Print("Adding to method: Equal\n")
-		found := tgt.MetaFile.FilterMethods(
+		a, b := tgt.RcvName+"."+fldNm, tgt.RcvName+"2."+fldNm
+		var cmp string
+		if isReflect {
+			vlog.V(2).Printf("Adding import: \"reflect\"\n")
+			tgt.MetaFile.Imports["reflect"] = struct{}{}
+			cmp = fmt.Sprintf("if !reflect.DeepEqual(%s, %s) {\n\treturn false\n}", a, b)
+		} else if cmp = equalStmts(tgt, a, b, tgt.FldType, epsilon, 0); cmp == "" {
+			continue
+		}
+
+		vlog.V(2).Printf("Adding to method: Equal\n")
+		found := tgt.MetaFile.FilterMethodsN(
 			func(m *meta.Method) bool {
 				return m.RcvName == tgt.RcvName && m.RcvType == tgt.RcvType && m.Name == "Equal"
 			},
@@ -397,29 +681,101 @@ func equal(tgt *Target, opts []string) {
 				RcvName: tgt.RcvName,
 				RcvType: tgt.RcvType,
 				Name:    "Equal",
-				Misc:    make(map[string]interface{}),
-				Tmpl:    "equal",
+				Misc: map[string]interface{}{
+					"Ptr": strings.HasPrefix(tgt.RcvType, "*"),
+				},
+				Tmpl: "equal",
 			}
 			tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, equal)
 		}
-		var cmp string
-		if len(opts) > 0 && opts[0] == optReflect {
-			log.Print("Adding import: \"reflect\"\n")
-			tgt.MetaFile.Imports["reflect"] = struct{}{}
-			cmp = fmt.Sprintf(
-				"if !reflect.DeepEqual(%s.%s, %s2.%s) {\n\t\treturn false\n\t}",
-				tgt.RcvName, fldNm, tgt.RcvName, fldNm,
-			)
-		} else {
-			cmp = fmt.Sprintf(
-				"if %s.%s != %s2.%s {\n\t\treturn false\n\t}",
-				tgt.RcvName, fldNm, tgt.RcvName, fldNm,
-			)
+		if isTyped {
+			equal.Misc["Typed"] = true
 		}
-		equal.Misc["Cmps"] = cmps + cmp
+		equal.Misc["Cmps"] = cmps + strings.Replace(cmp, "\n", "\n\t", -1)
 	}
 }
 
+// equalStmts returns statements that return false unless a and b, which are of type typ, are equivalent.
+// Slices, arrays, maps and pointers are compared element-wise; values whose type has an Equal method
+// that accepts the same type are compared using that method.
+// Returns "" if values of the type cannot be compared.
+func equalStmts(tgt *Target, a, b, typ, epsilon string, depth int) string {
+	const notEqual = "if %s {\n\treturn false\n}"
+
+	i, k, v, w := localName(tgt, "i", depth), localName(tgt, "k", depth), localName(tgt, "v", depth), localName(tgt, "w", depth)
+	ok := localName(tgt, "ok", 0)
+
+	elem := elemOf(typ)
+	switch kindOf(typ) {
+	case kindFloat:
+		if epsilon != "" {
+			vlog.V(2).Printf("Adding import: \"math\"\n")
+			tgt.MetaFile.Imports["math"] = struct{}{}
+			return fmt.Sprintf(notEqual, fmt.Sprintf("math.Abs(float64(%s-%s)) > %s", a, b, epsilon))
+		}
+	case kindSlice:
+		if elem == "byte" || elem == "uint8" {
+			vlog.V(2).Printf("Adding import: \"bytes\"\n")
+			tgt.MetaFile.Imports["bytes"] = struct{}{}
+			return fmt.Sprintf(notEqual, fmt.Sprintf("!bytes.Equal(%s, %s)", a, b))
+		}
+		stmts := equalStmts(tgt, a+"["+i+"]", b+"["+i+"]", elem, epsilon, depth+1)
+		if stmts == "" {
+			return ""
+		}
+		return fmt.Sprintf(notEqual, fmt.Sprintf("len(%s) != len(%s)", a, b)) +
+			fmt.Sprintf("\nfor %s := range %s {\n%s\n}", i, a, indent(stmts, 1))
+	case kindArray:
+		stmts := equalStmts(tgt, a+"["+i+"]", b+"["+i+"]", elem, epsilon, depth+1)
+		if stmts == "" {
+			return ""
+		}
+		return fmt.Sprintf("for %s := range %s {\n%s\n}", i, a, indent(stmts, 1))
+	case kindMap:
+		stmts := equalStmts(tgt, v, w, elem, epsilon, depth+1)
+		if stmts == "" {
+			return ""
+		}
+		return fmt.Sprintf(notEqual, fmt.Sprintf("len(%s) != len(%s)", a, b)) +
+			fmt.Sprintf("\nfor %s, %s := range %s {\n\t%s, %s := %s[%s]\n\tif !%s {\n\t\treturn false\n\t}\n%s\n}",
+				k, v, a, w, ok, b, k, ok, indent(stmts, 1))
+	case kindPtr:
+		deref := func(p string) string {
+			if k := kindOf(elem); k == kindSlice || k == kindArray || k == kindMap || k == kindOther {
+				return "(*" + p + ")"
+			}
+			return "*" + p
+		}
+		stmts := equalStmts(tgt, deref(a), deref(b), elem, epsilon, depth+1)
+		if stmts == "" {
+			return ""
+		}
+		return fmt.Sprintf(notEqual, fmt.Sprintf("(%s == nil) != (%s == nil)", a, b)) +
+			fmt.Sprintf("\nif %s != nil {\n%s\n}", a, indent(stmts, 1))
+	case kindOther:
+		t := lookupType(tgt, typ)
+		if t == nil {
+			tgt.warnf("skipping 'equal' - unknown type %s", typ)
+			return ""
+		}
+		if call := binaryCall(tgt, t, "Equal", "equal", types.Bool, a, b); call != "" {
+			return fmt.Sprintf(notEqual, "!"+call)
+		}
+		if !types.Comparable(t) {
+			tgt.warnf("skipping 'equal' - type %s has no Equal method and is not comparable", typ)
+			return ""
+		}
+	}
+	return fmt.Sprintf(notEqual, fmt.Sprintf("%s != %s", a, b))
+}
+
+// warnf logs a problem with the target and records it as a diagnostic.
+func (tgt *Target) warnf(format string, a ...interface{}) {
+	msg := fmt.Sprintf(format, a...)
+	vlog.V(0).Printf("%s\n", msg)
+	tgt.Diagnostics = append(tgt.Diagnostics, msg)
+}
+
 func first(s string) (string, int) {
 	if s == "" {
 		return "", 0
@@ -438,6 +794,14 @@ func upperFirst(s string) string {
 	return strings.ToUpper(f) + s[n:]
 }
 
+// joinNonEmpty appends elem to s, separated by sep unless s is empty.
+func joinNonEmpty(s, sep, elem string) string {
+	if s == "" {
+		return elem
+	}
+	return s + sep + elem
+}
+
 func argName(rcv, argType string) string {
 	subs := strings.Split(argType, ".")
 	arg, _ := first(subs[len(subs)-1])
@@ -0,0 +1,126 @@
+package directive
+
+import (
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+const optSingular = "singular="
+
+// irregularPlurals maps plural nouns that do not follow the rules of singularOf to their singular form.
+var irregularPlurals = map[string]string{
+	"children": "child",
+	"people":   "person",
+	"men":      "man",
+	"women":    "woman",
+	"mice":     "mouse",
+	"feet":     "foot",
+	"teeth":    "tooth",
+	"geese":    "goose",
+	"indices":  "index",
+	"vertices": "vertex",
+	"matrices": "matrix",
+	"criteria": "criterion",
+	"series":   "series",
+	"species":  "species",
+	"movies":   "movie",
+	"cookies":  "cookie",
+	"caches":   "cache",
+	"niches":   "niche",
+	"uses":     "use",
+	"causes":   "cause",
+	"clauses":  "clause",
+	"houses":   "house",
+	"quizzes":  "quiz",
+}
+
+// elems generates methods that access the individual elements of each name of the given (slice) field.
+// Method names use the singular of the field name (e.g. AddLabel for labels) unless it is specified by the singular= option.
+func elems(tgt *Target, opts []string) {
+	if kindOf(tgt.FldType) != kindSlice {
+		tgt.warnf("skipping 'elems' - not supported for type %s", tgt.FldType)
+		return
+	}
+
+	var sing string
+	for i := range opts {
+		if strings.HasPrefix(opts[i], optSingular) {
+			sing = upperFirst(strings.TrimPrefix(opts[i], optSingular))
+		}
+	}
+
+	elemType := elemOf(tgt.FldType)
+	isComp := isComparable(tgt, elemType)
+	if !isComp {
+		vlog.V(1).Printf("Not adding Remove and Contains methods - elements of type %s are not comparable\n", elemType)
+	}
+
+	ptrRcvType := tgt.RcvType
+	if !strings.HasPrefix(tgt.RcvType, "*") {
+		ptrRcvType = "*" + tgt.RcvType
+	}
+
+	for _, fldNm := range tgt.FldNames {
+		plural := upperFirst(fldNm)
+		singular := sing
+		if singular == "" {
+			singular = singularOf(plural)
+		}
+
+		vlog.V(2).Printf("Adding method: Add%s\n", singular)
+		if isComp {
+			vlog.V(2).Printf("Adding method: Remove%s\n", singular)
+		}
+		vlog.V(2).Printf("Adding method: %sAt\n", singular)
+		vlog.V(2).Printf("Adding method: %sLen\n", plural)
+		vlog.V(2).Printf("Adding method: Clear%s\n", plural)
+		if isComp {
+			vlog.V(2).Printf("Adding method: Contains%s\n", singular)
+		}
+		m := meta.Method{
+			RcvName: tgt.RcvName,
+			RcvType: tgt.RcvType,
+			ArgName: argName(tgt.RcvName, elemType),
+			ArgType: elemType,
+			FldName: fldNm,
+			Misc: map[string]interface{}{
+				"Singular":   singular,
+				"Plural":     plural,
+				"PtrType":    ptrRcvType,
+				"Comparable": isComp,
+			},
+			Tmpl: "elems",
+		}
+		tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &m)
+	}
+}
+
+// singularOf returns the singular of the given plural name (e.g. Label for Labels or UserID for UserIDs).
+// Only the last word of a camel-cased name is considered.
+func singularOf(name string) string {
+	lower := strings.ToLower(name)
+	for plural, singular := range irregularPlurals {
+		i := len(name) - len(plural)
+		// the irregular plural must be a whole word, i.e. the name itself or a capitalized suffix
+		if strings.HasSuffix(lower, plural) && (i == 0 || name[i:i+1] == strings.ToUpper(name[i:i+1])) {
+			return name[:i] + name[i:i+1] + singular[1:]
+		}
+	}
+
+	switch {
+	case strings.HasSuffix(name, "ies") && len(name) > 4:
+		return name[:len(name)-3] + "y"
+	case strings.HasSuffix(name, "sses"), strings.HasSuffix(name, "uses"), strings.HasSuffix(name, "shes"),
+		strings.HasSuffix(name, "ches"), strings.HasSuffix(name, "xes"), strings.HasSuffix(name, "zzes"):
+		// e.g. Statuses or Boxes, but not Sizes
+		return name[:len(name)-2]
+	case strings.HasSuffix(name, "ss"), strings.HasSuffix(name, "us"), strings.HasSuffix(name, "is"):
+		// already singular (e.g. Address, Status or Analysis)
+		return name
+	case strings.HasSuffix(name, "s") && len(name) > 1:
+		return name[:len(name)-1]
+	}
+	return name
+}
@@ -0,0 +1,46 @@
+package directive
+
+import "testing"
+
+func TestSingularOf(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "labels", want: "label"},
+		{name: "UserIDs", want: "UserID"},
+		{name: "entries", want: "entry"},
+		{name: "keys", want: "key"},
+		{name: "ties", want: "tie"},
+		{name: "series", want: "series"},
+		{name: "TimeSeries", want: "TimeSeries"},
+		{name: "species", want: "species"},
+		{name: "movies", want: "movie"},
+		{name: "classes", want: "class"},
+		{name: "statuses", want: "status"},
+		{name: "buses", want: "bus"},
+		{name: "causes", want: "cause"},
+		{name: "dishes", want: "dish"},
+		{name: "matches", want: "match"},
+		{name: "caches", want: "cache"},
+		{name: "LineCaches", want: "LineCache"},
+		{name: "boxes", want: "box"},
+		{name: "sizes", want: "size"},
+		{name: "buzzes", want: "buzz"},
+		{name: "quizzes", want: "quiz"},
+		{name: "address", want: "address"},
+		{name: "status", want: "status"},
+		{name: "analysis", want: "analysis"},
+		{name: "children", want: "child"},
+		{name: "people", want: "person"},
+		{name: "women", want: "woman"},
+		{name: "Indices", want: "Index"},
+		{name: "nodeIndices", want: "nodeIndex"},
+		{name: "data", want: "data"},
+	}
+	for _, tt := range tests {
+		if got := singularOf(tt.name); got != tt.want {
+			t.Errorf("singularOf(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
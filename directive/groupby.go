@@ -0,0 +1,111 @@
+package directive
+
+import (
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+// groupby generates a GroupBy method for each name of the given field.
+func groupby(tgt *Target, opts []string) {
+	if len(opts) < 1 {
+		tgt.warnf("skipping 'groupby' - must specify key type as first option")
+		return
+	}
+
+	key := opts[0]
+	opts = opts[1:]
+
+	var isOmitField bool
+	for i := range opts {
+		isOmitField = isOmitField || opts[i] == optOmitField
+	}
+
+	elemType := strings.TrimPrefix(tgt.FldType, "[]")
+	for _, fldNm := range tgt.FldNames {
+		method := "GroupBy"
+		if !isOmitField {
+			method += upperFirst(fldNm)
+		}
+
+		vlog.V(2).Printf("Adding method: %s\n", method)
+		g := meta.Method{
+			RcvName: tgt.RcvName,
+			RcvType: tgt.RcvType,
+			Name:    method,
+			ArgType: elemType,
+			RetVals: "map[" + key + "]" + tgt.FldType,
+			FldName: fldNm,
+			Misc: map[string]interface{}{
+				"KeyType": key,
+			},
+			Tmpl: "groupby",
+		}
+		tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &g)
+	}
+}
+
+// indexBy generates ByKey and FindByKey methods for each name of the given field,
+// provided that its elements are structs with the given key field.
+func indexBy(tgt *Target, opts []string, key string) {
+	elemType := strings.TrimPrefix(tgt.FldType, "[]")
+	t := lookupType(tgt, elemType)
+	if t == nil {
+		tgt.warnf("skipping 'index,%s%s' - unknown type %s", optBy, key, elemType)
+		return
+	}
+	kt := fieldType(tgt, t, key)
+	if kt == nil {
+		tgt.warnf("skipping 'index,%s%s' - type %s has no field %s", optBy, key, elemType, key)
+		return
+	}
+	if !types.Comparable(kt) {
+		tgt.warnf("skipping 'index,%s%s' - field of type %s is not comparable", optBy, key, kt)
+		return
+	}
+	keyType := typeString(tgt, kt)
+
+	var isOmitField bool
+	for i := range opts {
+		isOmitField = isOmitField || opts[i] == optOmitField
+	}
+
+	argNm := lowerFirst(key)
+	if strings.ToUpper(key) == key {
+		// e.g. ID -> id
+		argNm = strings.ToLower(key)
+	}
+	if token.IsKeyword(argNm) || argNm == tgt.RcvName || argNm == "i" {
+		// e.g. Type -> key, since type is reserved
+		argNm = "key"
+	}
+
+	for _, fldNm := range tgt.FldNames {
+		var fldPart string
+		if !isOmitField {
+			fldPart = upperFirst(fldNm)
+		}
+		method := fldPart + "By" + upperFirst(key)
+
+		vlog.V(2).Printf("Adding method: %s\n", method)
+		vlog.V(2).Printf("Adding method: Find%s\n", method)
+		ib := meta.Method{
+			RcvName: tgt.RcvName,
+			RcvType: tgt.RcvType,
+			Name:    method,
+			ArgName: argNm,
+			ArgType: elemType,
+			RetVals: "map[" + keyType + "]" + elemType,
+			FldName: fldNm,
+			Misc: map[string]interface{}{
+				"Key":     key,
+				"KeyType": keyType,
+			},
+			Tmpl: "index_by",
+		}
+		tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &ib)
+	}
+}
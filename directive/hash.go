@@ -0,0 +1,180 @@
+package directive
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+// hash adds each name of the given field to the Hash() implementation.
+func hash(tgt *Target, opts []string) {
+	vlog.V(2).Printf("Adding import: \"hash/fnv\"\n")
+	tgt.MetaFile.Imports["hash/fnv"] = struct{}{}
+
+	for _, d := range tgt.Directives {
+		if opts := strings.Split(d, ","); opts[0] == "equal" {
+			for _, opt := range opts[1:] {
+				if strings.HasPrefix(opt, optEpsilon) {
+					// values within epsilon of each other are equal, but cannot hash alike
+					tgt.warnf("skipping 'hash' - not consistent with 'equal,%s'", opt)
+					return
+				}
+			}
+		}
+	}
+
+	for _, fldNm := range tgt.FldNames {
+		vlog.V(2).Printf("Adding to method: Hash\n")
+		found := tgt.MetaFile.FilterMethodsN(
+			func(m *meta.Method) bool {
+				return m.RcvName == tgt.RcvName && m.RcvType == tgt.RcvType && m.Name == "Hash"
+			},
+			1,
+		)
+		var hash *meta.Method
+		if len(found) > 0 {
+			hash = found[0]
+		} else {
+			hasher := "h"
+			if hasher == tgt.RcvName {
+				// just double up
+				hasher += hasher
+			}
+			hash = &meta.Method{
+				RcvName: tgt.RcvName,
+				RcvType: tgt.RcvType,
+				Name:    "Hash",
+				RetVals: "uint64",
+				Misc: map[string]interface{}{
+					"Hasher": hasher,
+					"Writes": "",
+					"Ptr":    strings.HasPrefix(tgt.RcvType, "*"),
+				},
+				Tmpl: "hash",
+			}
+			tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, hash)
+		}
+
+		stmts := hashStmts(tgt, hash.Misc["Hasher"].(string), tgt.RcvName+"."+fldNm, tgt.FldType, 0)
+		hash.Misc["Writes"] = joinNonEmpty(hash.Misc["Writes"].(string), "\n\t", strings.Replace(stmts, "\n", "\n\t", -1))
+	}
+}
+
+// hashStmts returns statements that write x, which is of type typ, to the hasher h.
+// Map entries are combined independently of their order.
+// Values whose type has a Hash method are written using that method.
+// Returns "" if values of the type cannot be hashed consistently with Equal.
+func hashStmts(tgt *Target, h, x, typ string, depth int) string {
+	const write = "binary.Write(%s, binary.LittleEndian, %s)"
+
+	i, k, v := localName(tgt, "i", depth), localName(tgt, "k", depth), localName(tgt, "v", depth)
+	hk, sum := localName(tgt, "h", depth+1), localName(tgt, "sum", depth)
+
+	addImport := func(path string) {
+		vlog.V(2).Printf("Adding import: \"%s\"\n", path)
+		tgt.MetaFile.Imports[path] = struct{}{}
+	}
+
+	elem := elemOf(typ)
+	kd, isUnsigned, isNamed := kindOf(typ), strings.HasPrefix(typ, "u"), false
+	if kd == kindOther {
+		t := lookupType(tgt, typ)
+		if t == nil {
+			tgt.warnf("skipping 'hash' - unknown type %s", typ)
+			return ""
+		}
+		switch {
+		case hasHash(tgt, t):
+			addImport("encoding/binary")
+			return fmt.Sprintf(write, h, x+".Hash()")
+		case isTime(t):
+			// consistent with Time.Equal, which ignores the location and monotonic clock reading
+			addImport("encoding/binary")
+			return fmt.Sprintf(write, h, x+".UnixNano()")
+		}
+		// named string, numeric and boolean types are hashed like their underlying type
+		if kd = underlyingKind(t); kd == kindOther {
+			tgt.warnf("skipping 'hash' - type %s has no Hash method", typ)
+			return ""
+		}
+		isUnsigned, isNamed = t.Underlying().(*types.Basic).Info()&types.IsUnsigned != 0, true
+	}
+
+	switch kd {
+	case kindString:
+		addImport("encoding/binary")
+		addImport("io")
+		s := x
+		if isNamed {
+			s = "string(" + x + ")"
+		}
+		return fmt.Sprintf(write, h, "uint64(len("+x+"))") + fmt.Sprintf("\nio.WriteString(%s, %s)", h, s)
+	case kindInt:
+		addImport("encoding/binary")
+		if isUnsigned {
+			return fmt.Sprintf(write, h, "uint64("+x+")")
+		}
+		return fmt.Sprintf(write, h, "int64("+x+")")
+	case kindFloat:
+		addImport("encoding/binary")
+		return fmt.Sprintf(write, h, "float64("+x+")")
+	case kindComplex:
+		addImport("encoding/binary")
+		return fmt.Sprintf(write, h, "complex128("+x+")")
+	case kindBool:
+		addImport("encoding/binary")
+		if isNamed {
+			return fmt.Sprintf(write, h, "bool("+x+")")
+		}
+		return fmt.Sprintf(write, h, x)
+	case kindSlice, kindArray:
+		addImport("encoding/binary")
+		if typ == "[]byte" {
+			return fmt.Sprintf(write, h, "uint64(len("+x+"))") + fmt.Sprintf("\n%s.Write(%s)", h, x)
+		}
+		stmts := hashStmts(tgt, h, x+"["+i+"]", elem, depth+1)
+		if stmts == "" {
+			return ""
+		}
+		return fmt.Sprintf(write, h, "uint64(len("+x+"))") +
+			fmt.Sprintf("\nfor %s := range %s {\n%s\n}", i, x, indent(stmts, 1))
+	case kindMap:
+		addImport("encoding/binary")
+		key, val := hashStmts(tgt, hk, k, keyOf(typ), depth+1), hashStmts(tgt, hk, v, elem, depth+1)
+		if key == "" || val == "" {
+			return ""
+		}
+		// each map gets its own scope so that several maps can declare the sum
+		block := fmt.Sprintf("var %s uint64\nfor %s, %s := range %s {\n\t%s := fnv.New64a()\n%s\n\t%s += %s.Sum64()\n}\n",
+			sum, k, v, x, hk, indent(key+"\n"+val, 1), sum, hk) +
+			fmt.Sprintf(write, h, "uint64(len("+x+"))") + "\n" + fmt.Sprintf(write, h, sum)
+		return "{\n" + indent(block, 1) + "\n}"
+	case kindPtr:
+		addImport("encoding/binary")
+		deref := "*" + x
+		if k := kindOf(elem); k == kindSlice || k == kindArray || k == kindMap || k == kindOther {
+			deref = "(" + deref + ")"
+		}
+		stmts := hashStmts(tgt, h, deref, elem, depth+1)
+		if stmts == "" {
+			return ""
+		}
+		return fmt.Sprintf(write, h, x+" != nil") +
+			fmt.Sprintf("\nif %s != nil {\n%s\n}", x, indent(stmts, 1))
+	}
+	addImport("fmt")
+	return fmt.Sprintf("fmt.Fprint(%s, %s)", h, x)
+}
+
+// hasHash answers whether the given type has a Hash() uint64 method, with a value or pointer receiver.
+func hasHash(tgt *Target, t types.Type) bool {
+	if sig := methodOf(tgt, t, "Hash"); sig != nil {
+		return sig.Params().Len() == 0 && sig.Results().Len() == 1 &&
+			types.Identical(sig.Results().At(0).Type(), types.Typ[types.Uint64])
+	}
+	ok, _ := generatesMethod(tgt, t, "hash")
+	return ok
+}
@@ -0,0 +1,50 @@
+package directive
+
+import (
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+// heap generates methods that implement heap.Interface for the first name of the given field,
+// on top of the methods generated by sort.
+func heap(tgt *Target, opts []string) {
+	fldNm := tgt.FldNames[0]
+	found := tgt.MetaFile.FilterMethodsN(
+		func(m *meta.Method) bool {
+			return m.RcvName == tgt.RcvName && m.RcvType == tgt.RcvType && m.Name == "Sort" && m.FldName == fldNm
+		},
+		1,
+	)
+	if len(found) < 1 {
+		tgt.warnf("skipping 'heap' of %s - must follow 'sort'", fldNm)
+		return
+	}
+	if order, _ := found[0].Misc["Order"].(string); order == optFunc {
+		tgt.warnf("skipping 'heap' of %s - not supported for 'sort,%s'", fldNm, optFunc)
+		return
+	}
+
+	vlog.V(2).Printf("Adding import: \"container/heap\"\n")
+	tgt.MetaFile.Imports["container/heap"] = struct{}{}
+
+	vlog.V(2).Printf("Adding method: Push\n")
+	vlog.V(2).Printf("Adding method: Pop\n")
+	vlog.V(2).Printf("Adding method: PushItem\n")
+	vlog.V(2).Printf("Adding method: PopItem\n")
+	vlog.V(2).Printf("Adding method: Peek\n")
+	vlog.V(2).Printf("Adding method: Fix\n")
+	h := meta.Method{
+		RcvName: tgt.RcvName,
+		RcvType: tgt.RcvType,
+		Name:    "Push",
+		ArgType: strings.TrimPrefix(tgt.FldType, "[]"),
+		FldName: fldNm,
+		Misc: map[string]interface{}{
+			"PtrType": "*" + strings.TrimPrefix(tgt.RcvType, "*"),
+		},
+		Tmpl: "heap",
+	}
+	tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &h)
+}
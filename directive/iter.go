@@ -0,0 +1,80 @@
+package directive
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+const (
+	optFilter = "filter"
+	optMap    = "map="
+)
+
+// iter generates iterator methods for each name of the given (slice or map) field.
+func iter(tgt *Target, opts []string) {
+	k := kindOf(tgt.FldType)
+	if k != kindSlice && k != kindMap {
+		tgt.warnf("skipping 'iter' - not supported for type %s", tgt.FldType)
+		return
+	}
+
+	var isOmitField, isFilter bool
+	var results []string
+	for i := range opts {
+		isOmitField = isOmitField || opts[i] == optOmitField
+		isFilter = isFilter || opts[i] == optFilter
+		if strings.HasPrefix(opts[i], optMap) {
+			results = append(results, strings.TrimPrefix(opts[i], optMap))
+		}
+	}
+
+	vlog.V(2).Printf("Adding import: \"iter\"\n")
+	tgt.MetaFile.Imports["iter"] = struct{}{}
+
+	// slices yield elements, maps yield key-value pairs
+	var keyType string
+	seq, elemType := "iter.Seq[%s]", elemOf(tgt.FldType)
+	if k == kindMap {
+		keyType = keyOf(tgt.FldType)
+		seq = "iter.Seq2[" + keyType + ", %s]"
+	}
+
+	for _, fldNm := range tgt.FldNames {
+		var fldPart string
+		if !isOmitField {
+			fldPart = upperFirst(fldNm)
+		}
+
+		add := func(method, tmpl, retVals string, misc map[string]interface{}) {
+			vlog.V(2).Printf("Adding method: %s\n", method)
+			misc["KeyType"] = keyType
+			it := meta.Method{
+				RcvName: tgt.RcvName,
+				RcvType: tgt.RcvType,
+				Name:    method,
+				ArgType: elemType,
+				RetVals: retVals,
+				FldName: fldNm,
+				Misc:    misc,
+				Tmpl:    tmpl,
+			}
+			tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &it)
+		}
+
+		add("All"+fldPart, "iter", fmt.Sprintf(seq, elemType), map[string]interface{}{})
+		if isFilter {
+			add("Filter"+fldPart+"Seq", "iter_filter", fmt.Sprintf(seq, elemType), map[string]interface{}{})
+		}
+		for _, result := range results {
+			sel := result
+			if resSubs := strings.SplitN(result, ".", 2); len(resSubs) > 1 {
+				sel = resSubs[1]
+			}
+			method := fmt.Sprintf("Map%sTo%sSeq", fldPart, upperFirst(sel))
+			add(method, "iter_map", fmt.Sprintf(seq, result), map[string]interface{}{"Result": result})
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package directive
+
+import "strings"
+
+// kind classifies a type expression for code generation purposes
+type kind int
+
+const (
+	kindOther kind = iota
+	kindString
+	kindInt
+	kindFloat
+	kindComplex
+	kindBool
+	kindSlice
+	kindArray
+	kindMap
+	kindPtr
+	kindNilable
+)
+
+var basicKinds = map[string]kind{
+	"string":     kindString,
+	"int":        kindInt,
+	"int8":       kindInt,
+	"int16":      kindInt,
+	"int32":      kindInt,
+	"int64":      kindInt,
+	"uint":       kindInt,
+	"uint8":      kindInt,
+	"uint16":     kindInt,
+	"uint32":     kindInt,
+	"uint64":     kindInt,
+	"uintptr":    kindInt,
+	"byte":       kindInt,
+	"rune":       kindInt,
+	"float32":    kindFloat,
+	"float64":    kindFloat,
+	"complex64":  kindComplex,
+	"complex128": kindComplex,
+	"bool":       kindBool,
+	"error":      kindNilable,
+}
+
+// kindOf returns the kind of the given type expression.
+// Named types other than the predeclared ones are kindOther.
+func kindOf(typ string) kind {
+	if k, ok := basicKinds[typ]; ok {
+		return k
+	}
+	switch {
+	case strings.HasPrefix(typ, "[]"):
+		return kindSlice
+	case strings.HasPrefix(typ, "["):
+		return kindArray
+	case strings.HasPrefix(typ, "map["):
+		return kindMap
+	case strings.HasPrefix(typ, "*"):
+		return kindPtr
+	case strings.HasPrefix(typ, "interface"), strings.HasPrefix(typ, "func"), strings.HasPrefix(typ, "chan"), strings.HasPrefix(typ, "<-chan"):
+		return kindNilable
+	}
+	return kindOther
+}
+
+// isNumeric answers whether the kind is an integer or floating-point number.
+func (k kind) isNumeric() bool {
+	return k == kindInt || k == kindFloat
+}
+
+// hasLen answers whether values of the kind can be passed to len.
+func (k kind) hasLen() bool {
+	return k == kindString || k == kindSlice || k == kindArray || k == kindMap
+}
+
+// zeroCheck returns a boolean expression that answers whether expr is the zero value of typ.
+// The second return value is the import required by the expression, if any.
+func zeroCheck(expr, typ string) (string, string) {
+	switch kindOf(typ) {
+	case kindString:
+		return expr + ` == ""`, ""
+	case kindInt, kindFloat, kindComplex:
+		return expr + " == 0", ""
+	case kindBool:
+		return "!" + expr, ""
+	case kindSlice, kindMap:
+		return "len(" + expr + ") == 0", ""
+	case kindPtr, kindNilable:
+		return expr + " == nil", ""
+	}
+	return "reflect.ValueOf(" + expr + ").IsZero()", "reflect"
+}
+
+// elemOf returns the element type of the given slice, array, map or pointer type.
+func elemOf(typ string) string {
+	switch kindOf(typ) {
+	case kindSlice:
+		return typ[2:]
+	case kindPtr:
+		return typ[1:]
+	case kindArray, kindMap:
+		if i := closingBracket(typ); i > 0 {
+			return typ[i+1:]
+		}
+	}
+	return typ
+}
+
+// keyOf returns the key type of the given map type.
+func keyOf(typ string) string {
+	if i := closingBracket(typ); i > 0 && kindOf(typ) == kindMap {
+		return typ[len("map["):i]
+	}
+	return ""
+}
+
+// closingBracket returns the index of the bracket that closes the first opening bracket of typ.
+func closingBracket(typ string) int {
+	depth := 0
+	for i, r := range typ {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			if depth--; depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// zeroValue returns an expression for the zero value of typ.
+func zeroValue(typ string) string {
+	switch kindOf(typ) {
+	case kindString:
+		return `""`
+	case kindInt, kindFloat, kindComplex:
+		return "0"
+	case kindBool:
+		return "false"
+	case kindSlice, kindMap, kindPtr, kindNilable:
+		return "nil"
+	}
+	return "*new(" + typ + ")"
+}
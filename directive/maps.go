@@ -0,0 +1,158 @@
+package directive
+
+import (
+	"fmt"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+// lookup generates a method that returns the value stored under a key for each name of the given (map) field.
+func lookup(tgt *Target, opts []string) {
+	mapOp(tgt, opts, "lookup", "Get%sValue", "lookup")
+}
+
+// put generates a method that stores a value under a key for each name of the given (map) field.
+func put(tgt *Target, opts []string) {
+	mapOp(tgt, opts, "put", "Put%s", "put")
+}
+
+// runDelete generates a method that deletes the value stored under a key for each name of the given (map) field.
+func runDelete(tgt *Target, opts []string) {
+	mapOp(tgt, opts, "delete", "Delete%s", "delete")
+}
+
+// keys generates a method that returns the keys for each name of the given (map) field.
+func keys(tgt *Target, opts []string) {
+	mapOp(tgt, opts, "keys", "%sKeys", "keys")
+}
+
+// values generates a method that returns the values for each name of the given (map) field.
+func values(tgt *Target, opts []string) {
+	mapOp(tgt, opts, "values", "%sValues", "values")
+}
+
+// mapOp generates a method named after the given format for each name of the given (map) field.
+func mapOp(tgt *Target, opts []string, d, format, tmpl string) {
+	if kindOf(tgt.FldType) != kindMap {
+		tgt.warnf("skipping '%s' - not supported for type %s", d, tgt.FldType)
+		return
+	}
+
+	var isOmitField bool
+	for i := range opts {
+		isOmitField = isOmitField || opts[i] == optOmitField
+	}
+
+	keyType := keyOf(tgt.FldType)
+	rcvType := tgt.RcvType
+	misc := map[string]interface{}{"KeyType": keyType}
+	switch tmpl {
+	case "put":
+		// the map is made on demand, which requires a pointer receiver
+		if rcvType[0] != '*' {
+			rcvType = "*" + rcvType
+		}
+	case "keys", "values":
+		misc["Sorted"] = isOrderedKey(tgt, keyType)
+		if misc["Sorted"] == true {
+			vlog.V(2).Printf("Adding import: \"sort\"\n")
+			tgt.MetaFile.Imports["sort"] = struct{}{}
+		}
+	}
+
+	for _, fldNm := range tgt.FldNames {
+		var fldPart string
+		if !isOmitField {
+			fldPart = upperFirst(fldNm)
+		}
+		method := fmt.Sprintf(format, fldPart)
+
+		vlog.V(2).Printf("Adding method: %s\n", method)
+		m := meta.Method{
+			RcvName: tgt.RcvName,
+			RcvType: rcvType,
+			Name:    method,
+			ArgType: elemOf(tgt.FldType),
+			FldName: fldNm,
+			FldType: tgt.FldType,
+			Misc:    misc,
+			Tmpl:    tmpl,
+		}
+		tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &m)
+	}
+}
+
+// filterMap generates a filter method for each name of the given (map) field.
+func filterMap(tgt *Target, call callOpts, isOmitField, isChain, isInPlace bool) {
+	if call.parallel || call.fallible() || isInPlace {
+		tgt.warnf("ignoring '%s', '%s', '%s' and '%s' - not supported for type %s", optParallel, optCtx, optErr, optInPlace, tgt.FldType)
+	}
+
+	for _, fldNm := range tgt.FldNames {
+		method := "Filter"
+		if !isOmitField {
+			method += upperFirst(fldNm)
+		}
+
+		retVals, retStmt := tgt.FldType, "return result"
+		if isChain {
+			retVals = tgt.RcvType
+			retStmt = fmt.Sprintf("%s.%s = result\n\treturn %s", tgt.RcvName, fldNm, tgt.RcvName)
+		}
+
+		vlog.V(2).Printf("Adding method: %s\n", method)
+		filter := meta.Method{
+			RcvName: tgt.RcvName,
+			RcvType: tgt.RcvType,
+			Name:    method,
+			ArgType: elemOf(tgt.FldType),
+			RetVals: retVals,
+			FldName: fldNm,
+			FldType: tgt.FldType,
+			Misc:    map[string]interface{}{"KeyType": keyOf(tgt.FldType), "RetStmt": retStmt},
+			Tmpl:    "filter_map",
+		}
+		tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &filter)
+	}
+}
+
+// mapperMap generates a method that maps the values for each name of the given (map) field.
+func mapperMap(tgt *Target, call callOpts, result string, isOmitField bool) {
+	if call.parallel || call.fallible() {
+		tgt.warnf("ignoring '%s', '%s' and '%s' - not supported for type %s", optParallel, optCtx, optErr, tgt.FldType)
+	}
+
+	keyType := keyOf(tgt.FldType)
+	for _, fldNm := range tgt.FldNames {
+		var fldPart string
+		if !isOmitField {
+			fldPart = upperFirst(fldNm)
+		}
+		method := fmt.Sprintf("Map%sValues", fldPart)
+
+		vlog.V(2).Printf("Adding method: %s\n", method)
+		mapper := meta.Method{
+			RcvName: tgt.RcvName,
+			RcvType: tgt.RcvType,
+			Name:    method,
+			ArgType: fmt.Sprintf("func(%s) %s", elemOf(tgt.FldType), result),
+			RetVals: fmt.Sprintf("map[%s]%s", keyType, result),
+			FldName: fldNm,
+			Tmpl:    "mapper_map",
+		}
+		tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &mapper)
+	}
+}
+
+// isOrderedKey answers whether keys of the given type can be sorted using <.
+func isOrderedKey(tgt *Target, keyType string) bool {
+	switch kindOf(keyType) {
+	case kindString, kindInt, kindFloat:
+		return true
+	case kindOther:
+		t := lookupType(tgt, keyType)
+		return t != nil && isOrdered(t)
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package directive
+
+import (
+	"fmt"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+// option generates a functional option for each name of the given field
+// and makes the New() implementation accept options.
+func option(tgt *Target, opts []string) {
+	typ := strings.TrimPrefix(tgt.RcvType, "*")
+	optionNm := typ + "Option"
+
+	var dflt string
+	for i := range opts {
+		if strings.HasPrefix(opts[i], optDefault) {
+			dflt = strings.TrimPrefix(opts[i], optDefault)
+			if kindOf(tgt.FldType) == kindString {
+				dflt = strconv.Quote(dflt)
+			}
+		}
+	}
+
+	if !hasType(tgt, optionNm) {
+		vlog.V(2).Printf("Adding type: %s\n", optionNm)
+		tgt.MetaFile.Types = append(tgt.MetaFile.Types, meta.Type{
+			Name:  optionNm,
+			Embed: typ,
+			Misc:  map[string]interface{}{"Base": upperFirst(typ)},
+			Tmpl:  "type_option",
+		})
+	}
+
+	new := newMethod(tgt)
+	new.Misc["Option"] = optionNm
+
+	elemType := strings.TrimPrefix(tgt.FldType, "[]")
+	argType := tgt.FldType
+	if kindOf(tgt.FldType) == kindSlice {
+		argType = "..." + elemType
+	}
+	arg := argName(tgt.RcvName, elemType)
+
+	for _, fldNm := range tgt.FldNames {
+		method := "With" + upperFirst(fldNm)
+		if optionClashes(tgt, typ, fldNm) {
+			// e.g. WithServerName, since WithName is also generated for another struct
+			method = "With" + upperFirst(typ) + upperFirst(fldNm)
+		}
+
+		vlog.V(2).Printf("Adding method: %s\n", method)
+		with := meta.Method{
+			RcvName: tgt.RcvName,
+			RcvType: "*" + typ,
+			Name:    method,
+			ArgName: arg,
+			ArgType: argType,
+			RetVals: optionNm,
+			FldName: fldNm,
+			Tmpl:    "option",
+		}
+		tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &with)
+
+		if dflt != "" {
+			vlog.V(2).Printf("Adding to method: %s\n", new.Name)
+			new.Misc["Fields"] = joinNonEmpty(new.Misc["Fields"].(string), "\n\t\t", fmt.Sprintf("%s: %s,", fldNm, dflt))
+		}
+	}
+}
+
+// optionClashes answers whether the With function of the given field of typ would clash with another function
+// of the package, i.e. one that is declared explicitly or generated for the same field of another struct.
+func optionClashes(tgt *Target, typ, fldNm string) bool {
+	method := "With" + upperFirst(fldNm)
+	found := tgt.MetaFile.FilterMethodsN(
+		func(m *meta.Method) bool {
+			return m.Name == method && m.Tmpl == "option" && m.RcvType != "*"+typ
+		},
+		1,
+	)
+	if len(found) > 0 || tgt.Pkg == nil {
+		return len(found) > 0
+	}
+	scope := tgt.Pkg.Scope()
+	if scope.Lookup(method) != nil {
+		return true
+	}
+	for _, name := range scope.Names() {
+		obj, _ := scope.Lookup(name).(*types.TypeName)
+		if obj == nil || name == typ {
+			continue
+		}
+		st, _ := obj.Type().Underlying().(*types.Struct)
+		if st == nil {
+			continue
+		}
+		for i := 0; i < st.NumFields(); i++ {
+			if st.Field(i).Name() != fldNm {
+				continue
+			}
+			for _, fd := range strings.Split(reflect.StructTag(st.Tag(i)).Get("meta"), ";") {
+				if strings.SplitN(fd, ",", 2)[0] == "option" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package directive
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+)
+
+const (
+	optParallel = "parallel"
+	optCtx      = "ctx"
+	optErr      = "err"
+	optJoin     = "join"
+)
+
+// callOpts are the options of mapper and filter that determine how the given function is called.
+type callOpts struct {
+	// parallel answers whether the function is called by several goroutines
+	parallel bool
+	// workers is the number of goroutines, GOMAXPROCS if empty
+	workers string
+	// ctx answers whether a context is passed through to the function
+	ctx bool
+	// err answers whether the function can fail
+	err bool
+	// join answers whether all errors are returned rather than just the first one
+	join bool
+}
+
+// fallible answers whether the generated method returns an error.
+func (c callOpts) fallible() bool {
+	return c.ctx || c.err
+}
+
+// parseCallOpts returns the call options among the given options.
+func parseCallOpts(tgt *Target, opts []string) callOpts {
+	var c callOpts
+	for i := range opts {
+		switch {
+		case opts[i] == optParallel:
+			c.parallel = true
+		case strings.HasPrefix(opts[i], optParallel+"="):
+			c.parallel = true
+			workers := strings.TrimPrefix(opts[i], optParallel+"=")
+			// fewer than one goroutine would never process the elements
+			if n, err := strconv.Atoi(workers); err != nil || n < 1 {
+				tgt.warnf("ignoring '%s' - number of goroutines must be a positive integer", opts[i])
+				continue
+			}
+			c.workers = workers
+		case opts[i] == optCtx:
+			c.ctx = true
+		case opts[i] == optErr:
+			c.err = true
+		case opts[i] == optJoin:
+			c.join = true
+		}
+	}
+	if c.join && !c.err {
+		tgt.warnf("ignoring '%s' - requires '%s'", optJoin, optErr)
+		c.join = false
+	}
+	return c
+}
+
+// misc returns the template values for the call options and adds the imports they require.
+func (c callOpts) misc(tgt *Target) map[string]interface{} {
+	addImport := func(path string) {
+		vlog.V(2).Printf("Adding import: \"%s\"\n", path)
+		tgt.MetaFile.Imports[path] = struct{}{}
+	}
+
+	if c.parallel {
+		addImport("sync")
+		if c.workers == "" {
+			addImport("runtime")
+		}
+	}
+	// the remaining work is cancelled on the first error, unless all errors are collected
+	cancel := c.parallel && (c.ctx || (c.err && !c.join))
+	if c.ctx || cancel {
+		addImport("context")
+	}
+	if c.err {
+		addImport("fmt")
+	}
+	if c.join {
+		addImport("errors")
+	}
+
+	return map[string]interface{}{
+		"Workers":  c.workers,
+		"Ctx":      c.ctx,
+		"Err":      c.err,
+		"Join":     c.join,
+		"Fallible": c.fallible(),
+		"Cancel":   cancel,
+	}
+}
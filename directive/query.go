@@ -0,0 +1,107 @@
+package directive
+
+import (
+	"go/types"
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+// runAny generates an Any method for each name of the given field.
+func runAny(tgt *Target, opts []string) {
+	query(tgt, opts, "Any", "any")
+}
+
+// all generates an All method for each name of the given field,
+// unless the iter directive of the field generates an All method of the same name.
+func all(tgt *Target, opts []string) {
+	for _, d := range tgt.Directives {
+		if iterOpts := strings.Split(d, ","); iterOpts[0] == "iter" {
+			if hasOmitField(opts) == hasOmitField(append(iterOpts[1:], tgt.DfltOpts...)) {
+				tgt.warnf("skipping 'all' - All method is generated by 'iter'")
+				return
+			}
+		}
+	}
+	query(tgt, opts, "All", "all")
+}
+
+// hasOmitField answers whether the given options contain omitfield.
+func hasOmitField(opts []string) bool {
+	for i := range opts {
+		if opts[i] == optOmitField {
+			return true
+		}
+	}
+	return false
+}
+
+// count generates a Count method for each name of the given field.
+func count(tgt *Target, opts []string) {
+	query(tgt, opts, "Count", "count")
+}
+
+// find generates a Find method for each name of the given field.
+func find(tgt *Target, opts []string) {
+	query(tgt, opts, "Find", "find")
+}
+
+// index generates an Index method for each name of the given field,
+// or ByKey and FindByKey methods if a key field is specified (i.e. by=Key).
+func index(tgt *Target, opts []string) {
+	for i := range opts {
+		if strings.HasPrefix(opts[i], optBy) {
+			indexBy(tgt, opts, strings.TrimPrefix(opts[i], optBy))
+			return
+		}
+	}
+	query(tgt, opts, "Index", "index")
+}
+
+// contains generates a Contains method for each name of the given field.
+func contains(tgt *Target, opts []string) {
+	elemType := strings.TrimPrefix(tgt.FldType, "[]")
+	if !isComparable(tgt, elemType) {
+		tgt.warnf("skipping 'contains' - elements of type %s are not comparable", elemType)
+		return
+	}
+	query(tgt, opts, "Contains", "contains")
+}
+
+// query generates a method that inspects the elements of each name of the given (slice) field.
+// Method names follow the rules of filter, i.e. the prefix followed by the name of the field unless omitfield is specified.
+func query(tgt *Target, opts []string, prefix, tmpl string) {
+	var isOmitField bool
+	for i := range opts {
+		isOmitField = isOmitField || opts[i] == optOmitField
+	}
+
+	for _, fldNm := range tgt.FldNames {
+		method := prefix
+		if !isOmitField {
+			method += upperFirst(fldNm)
+		}
+
+		vlog.V(2).Printf("Adding method: %s\n", method)
+		q := meta.Method{
+			RcvName: tgt.RcvName,
+			RcvType: tgt.RcvType,
+			Name:    method,
+			ArgType: strings.TrimPrefix(tgt.FldType, "[]"),
+			FldName: fldNm,
+			Tmpl:    tmpl,
+		}
+		tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &q)
+	}
+}
+
+// isComparable answers whether values of the given type can be compared using ==.
+// Without type information, only slices, maps and funcs are known not to be.
+func isComparable(tgt *Target, typ string) bool {
+	if t := lookupType(tgt, typ); t != nil {
+		return types.Comparable(t)
+	}
+	k := kindOf(typ)
+	return k != kindSlice && k != kindMap && !strings.HasPrefix(typ, "func(")
+}
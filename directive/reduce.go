@@ -0,0 +1,119 @@
+package directive
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+const (
+	optAggSum = "sum"
+	optAggMin = "min"
+	optAggMax = "max"
+	optAggAvg = "avg"
+)
+
+// reduce generates a reduce method and/or numeric aggregate methods for each name of the given field.
+func reduce(tgt *Target, opts []string) {
+	var result string
+	var isOmitField bool
+	var aggs []string
+	for i := range opts {
+		switch opts[i] {
+		case optOmitField:
+			isOmitField = true
+		case optAggSum, optAggMin, optAggMax, optAggAvg:
+			aggs = append(aggs, opts[i])
+		case optChain:
+			// not applicable
+		default:
+			if result == "" {
+				result = opts[i]
+			}
+		}
+	}
+	if result == "" && len(aggs) < 1 {
+		tgt.warnf("skipping 'reduce' - must specify result type or aggregate as first option")
+		return
+	}
+
+	elemType := strings.TrimPrefix(tgt.FldType, "[]")
+	k := numericKind(tgt, elemType)
+
+	for _, fldNm := range tgt.FldNames {
+		var fldPart string
+		if !isOmitField {
+			fldPart = upperFirst(fldNm)
+		}
+
+		if result != "" {
+			sel := result
+			if resSubs := strings.SplitN(result, ".", 2); len(resSubs) > 1 {
+				sel = resSubs[1]
+			}
+			method := fmt.Sprintf("Reduce%sTo%s", fldPart, upperFirst(sel))
+
+			vlog.V(2).Printf("Adding method: %s\n", method)
+			r := meta.Method{
+				RcvName: tgt.RcvName,
+				RcvType: tgt.RcvType,
+				Name:    method,
+				ArgType: elemType,
+				RetVals: result,
+				FldName: fldNm,
+				Tmpl:    "reduce",
+			}
+			tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &r)
+		}
+
+		for _, agg := range aggs {
+			if k != kindInt && k != kindFloat && (k != kindComplex || agg != optAggSum) {
+				tgt.warnf("skipping 'reduce,%s' - not supported for elements of type %s", agg, elemType)
+				continue
+			}
+
+			method := upperFirst(agg) + fldPart
+			vlog.V(2).Printf("Adding method: %s\n", method)
+			a := meta.Method{
+				RcvName: tgt.RcvName,
+				RcvType: tgt.RcvType,
+				Name:    method,
+				ArgType: elemType,
+				FldName: fldNm,
+				Misc:    map[string]interface{}{},
+				Tmpl:    agg,
+			}
+			switch agg {
+			case optAggMin:
+				a.Misc["Op"], a.Misc["Desc"] = "<", "smallest"
+				a.Tmpl = "min_max"
+			case optAggMax:
+				a.Misc["Op"], a.Misc["Desc"] = ">", "largest"
+				a.Tmpl = "min_max"
+			}
+			tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &a)
+		}
+	}
+}
+
+// numericKind returns the kind of the given numeric type (kindInt, kindFloat or kindComplex),
+// taking into account named types if type information is available.
+func numericKind(tgt *Target, typ string) kind {
+	k := kindOf(typ)
+	if t := lookupType(tgt, typ); k == kindOther && t != nil {
+		if b, ok := t.Underlying().(*types.Basic); ok {
+			switch info := b.Info(); {
+			case info&types.IsInteger != 0:
+				return kindInt
+			case info&types.IsFloat != 0:
+				return kindFloat
+			case info&types.IsComplex != 0:
+				return kindComplex
+			}
+		}
+	}
+	return k
+}
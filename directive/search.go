@@ -0,0 +1,62 @@
+package directive
+
+import (
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+// search generates binary search methods for the first name of the given field,
+// using the ordering of the Sort method generated by sort.
+func search(tgt *Target, opts []string) {
+	fldNm := tgt.FldNames[0]
+	found := tgt.MetaFile.FilterMethodsN(
+		func(m *meta.Method) bool {
+			return m.RcvName == tgt.RcvName && m.RcvType == tgt.RcvType && m.Name == "Sort" && m.FldName == fldNm
+		},
+		1,
+	)
+	if len(found) < 1 {
+		tgt.warnf("skipping 'search' of %s - must follow 'sort'", fldNm)
+		return
+	}
+	srt := found[0]
+	order, _ := srt.Misc["Order"].(string)
+	by, _ := srt.Misc["By"].(string)
+	if order == "" {
+		tgt.warnf("skipping 'search' of %s - 'sort' has no ordering option", fldNm)
+		return
+	}
+
+	// x[i] is compared to v, in both directions
+	elemType := strings.TrimPrefix(tgt.FldType, "[]")
+	x, v := tgt.RcvName+"."+fldNm+"[i]", "v"
+	if srt.Misc["Desc"].(bool) {
+		x, v = v, x
+	}
+	elemLess := lessExpr(tgt, order, by, elemType, x, v)
+	valueLess := lessExpr(tgt, order, by, elemType, v, x)
+	if elemLess == "" {
+		return
+	}
+
+	vlog.V(2).Printf("Adding method: Search\n")
+	vlog.V(2).Printf("Adding method: InsertSorted\n")
+	vlog.V(2).Printf("Adding method: RemoveSorted\n")
+	s := meta.Method{
+		RcvName: tgt.RcvName,
+		RcvType: tgt.RcvType,
+		Name:    "Search",
+		ArgType: elemType,
+		FldName: fldNm,
+		Misc: map[string]interface{}{
+			"PtrType":   "*" + strings.TrimPrefix(tgt.RcvType, "*"),
+			"Func":      order == optFunc,
+			"ElemLess":  elemLess,
+			"ValueLess": valueLess,
+		},
+		Tmpl: "search",
+	}
+	tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &s)
+}
@@ -0,0 +1,74 @@
+package directive
+
+import (
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+// distinct generates a Distinct method for each name of the given field.
+// Elements are compared directly unless a key type is specified, in which case the method accepts a key function.
+func distinct(tgt *Target, opts []string) {
+	var key string
+	for i := range opts {
+		if opts[i] != optOmitField && opts[i] != optChain && key == "" {
+			key = opts[i]
+		}
+	}
+
+	elemType := strings.TrimPrefix(tgt.FldType, "[]")
+	if key == "" && !isComparable(tgt, elemType) {
+		tgt.warnf("skipping 'distinct' - elements of type %s are not comparable, specify a key type", elemType)
+		return
+	}
+	sliceOp(tgt, opts, "Distinct", "distinct", map[string]interface{}{"KeyType": key})
+}
+
+// partition generates a Partition method for each name of the given field.
+func partition(tgt *Target, opts []string) {
+	sliceOp(tgt, opts, "Partition", "partition", map[string]interface{}{})
+}
+
+// chunk generates a Chunk method for each name of the given field.
+func chunk(tgt *Target, opts []string) {
+	sliceOp(tgt, opts, "Chunk", "chunk", map[string]interface{}{})
+}
+
+// sliceOp generates a method that derives new slices from each name of the given (slice) field.
+// Method names follow the rules of filter. In chain mode, the results are copies of the receiver that hold the new slices.
+func sliceOp(tgt *Target, opts []string, prefix, tmpl string, misc map[string]interface{}) {
+	var isOmitField, isChain bool
+	for i := range opts {
+		isOmitField = isOmitField || opts[i] == optOmitField
+		isChain = isChain || opts[i] == optChain
+	}
+	misc["Chain"] = isChain
+	misc["Ptr"] = strings.HasPrefix(tgt.RcvType, "*")
+
+	for _, fldNm := range tgt.FldNames {
+		method := prefix
+		if !isOmitField {
+			method += upperFirst(fldNm)
+		}
+
+		retVals := tgt.FldType
+		if isChain {
+			retVals = tgt.RcvType
+		}
+
+		vlog.V(2).Printf("Adding method: %s\n", method)
+		m := meta.Method{
+			RcvName: tgt.RcvName,
+			RcvType: tgt.RcvType,
+			Name:    method,
+			ArgType: strings.TrimPrefix(tgt.FldType, "[]"),
+			RetVals: retVals,
+			FldName: fldNm,
+			FldType: tgt.FldType,
+			Misc:    misc,
+			Tmpl:    tmpl,
+		}
+		tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, &m)
+	}
+}
@@ -0,0 +1,168 @@
+package directive
+
+import (
+	"go/types"
+	"reflect"
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+)
+
+// lookupType returns the type with the given name (e.g. "Person", "*Person" or "time.Time")
+// as seen from the package of the target, or nil if it is unknown.
+func lookupType(tgt *Target, name string) types.Type {
+	if tgt.Pkg == nil {
+		return nil
+	}
+	isPtr := strings.HasPrefix(name, "*")
+	name = strings.TrimPrefix(name, "*")
+
+	scope := tgt.Pkg.Scope()
+	if i := strings.Index(name, "."); i >= 0 {
+		scope = nil
+		for _, imp := range tgt.Pkg.Imports() {
+			if imp.Name() == name[:i] {
+				scope = imp.Scope()
+			}
+		}
+		if scope == nil {
+			return nil
+		}
+		name = name[i+1:]
+	}
+
+	obj, ok := scope.Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil
+	}
+	if isPtr {
+		return types.NewPointer(obj.Type())
+	}
+	return obj.Type()
+}
+
+// fieldType returns the type of the named field of t, or nil if there is no such field.
+func fieldType(tgt *Target, t types.Type, name string) types.Type {
+	obj, _, _ := types.LookupFieldOrMethod(t, true, tgt.Pkg, name)
+	if v, ok := obj.(*types.Var); ok && v.IsField() {
+		return v.Type()
+	}
+	return nil
+}
+
+// hasBinaryMethod answers whether t has the named method, accepts another t and returns a value of the given kind
+// (e.g. Compare(T) int or Before(T) bool).
+func hasBinaryMethod(tgt *Target, t types.Type, name string, result types.BasicKind) bool {
+	sig := methodOf(tgt, t, name)
+	if sig == nil {
+		return false
+	}
+	if sig.Params().Len() != 1 || sig.Results().Len() != 1 || !types.Identical(sig.Params().At(0).Type(), t) {
+		return false
+	}
+	res, ok := sig.Results().At(0).Type().(*types.Basic)
+	return ok && res.Kind() == result
+}
+
+// isOrdered answers whether values of t can be compared using <.
+func isOrdered(t types.Type) bool {
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Info()&types.IsOrdered != 0
+}
+
+// methodOf returns the signature of the named method of t, or nil if there is no such method.
+// Methods with pointer receivers are included, since generated code calls methods on addressable values.
+func methodOf(tgt *Target, t types.Type, name string) *types.Signature {
+	obj, _, _ := types.LookupFieldOrMethod(t, true, tgt.Pkg, name)
+	if fn, ok := obj.(*types.Func); ok {
+		return fn.Type().(*types.Signature)
+	}
+	return nil
+}
+
+// binaryCall returns an expression that calls the named method of a with b as the argument (e.g. "a.Equal(b)"),
+// where a and b are addressable values of type t and the method accepts another t (or a pointer to one)
+// and returns a value of the given kind. The method may also be one that the directive d generates for t.
+// Returns "" if t has no such method.
+func binaryCall(tgt *Target, t types.Type, name, d string, result types.BasicKind, a, b string) string {
+	switch {
+	case hasBinaryMethod(tgt, t, name, result):
+		return a + "." + name + "(" + b + ")"
+	case hasBinaryMethod(tgt, types.NewPointer(t), name, result):
+		return a + "." + name + "(&" + b + ")"
+	}
+	if ok, isPtr := generatesMethod(tgt, t, d); ok {
+		if isPtr {
+			return a + "." + name + "(&" + b + ")"
+		}
+		return a + "." + name + "(" + b + ")"
+	}
+	return ""
+}
+
+// generatesMethod answers whether the directive d generates a method for t, a struct type of the target package,
+// and whether the method has a pointer receiver.
+// Generated files are not type-checked, so such methods are only known from the meta tags of t.
+func generatesMethod(tgt *Target, t types.Type, d string) (ok, isPtr bool) {
+	named, _ := t.(*types.Named)
+	if named == nil || named.Obj().Pkg() != tgt.Pkg {
+		return false, false
+	}
+	st, _ := named.Underlying().(*types.Struct)
+	if st == nil {
+		return false, false
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		// ptr applies to the directives that follow it
+		isPtr = false
+		for _, fd := range strings.Split(reflect.StructTag(st.Tag(i)).Get("meta"), ";") {
+			switch strings.SplitN(fd, ",", 2)[0] {
+			case "ptr":
+				isPtr = true
+			case d:
+				return true, isPtr
+			}
+		}
+	}
+	return false, false
+}
+
+// underlyingKind returns the kind of the underlying type of t if it is a string, numeric or boolean type,
+// otherwise kindOther.
+func underlyingKind(t types.Type) kind {
+	b, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return kindOther
+	}
+	switch info := b.Info(); {
+	case info&types.IsString != 0:
+		return kindString
+	case info&types.IsInteger != 0:
+		return kindInt
+	case info&types.IsFloat != 0:
+		return kindFloat
+	case info&types.IsComplex != 0:
+		return kindComplex
+	case info&types.IsBoolean != 0:
+		return kindBool
+	}
+	return kindOther
+}
+
+// typeString returns the name of t as seen from the package of the target, adding imports as needed.
+func typeString(tgt *Target, t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if p == tgt.Pkg {
+			return ""
+		}
+		vlog.V(2).Printf("Adding import: \"%s\"\n", p.Path())
+		tgt.MetaFile.Imports[p.Path()] = struct{}{}
+		return p.Name()
+	})
+}
+
+// isTime answers whether t is time.Time.
+func isTime(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Time"
+}
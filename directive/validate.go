@@ -0,0 +1,200 @@
+package directive
+
+import (
+	"fmt"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/phelmkamp/metatag/internal/vlog"
+	"github.com/phelmkamp/metatag/meta"
+)
+
+const (
+	optRequired = "required"
+	optNonZero  = "nonzero"
+	optMin      = "min="
+	optMax      = "max="
+	optLen      = "len="
+	optOneOf    = "oneof="
+	optRegexp   = "regexp="
+)
+
+// validate adds each name of the given field to the Validate() implementation.
+func validate(tgt *Target, opts []string) {
+	vlog.V(2).Printf("Adding import: \"errors\"\n")
+	tgt.MetaFile.Imports["errors"] = struct{}{}
+
+	k := kindOf(tgt.FldType)
+	var isNamed bool
+	if k == kindOther {
+		// named string and numeric types (e.g. time.Duration) are checked like their underlying type
+		if t := lookupType(tgt, tgt.FldType); t != nil {
+			k, isNamed = underlyingKind(t), true
+		}
+	}
+	for _, fldNm := range tgt.FldNames {
+		vlog.V(2).Printf("Adding to method: Validate\n")
+		found := tgt.MetaFile.FilterMethodsN(
+			func(m *meta.Method) bool {
+				return m.RcvName == tgt.RcvName && m.RcvType == tgt.RcvType && m.Name == "Validate"
+			},
+			1,
+		)
+		var checks []string
+		var validate *meta.Method
+		if len(found) > 0 {
+			validate = found[0]
+			checks = append(checks, validate.Misc["Checks"].(string))
+		} else {
+			validate = &meta.Method{
+				RcvName: tgt.RcvName,
+				RcvType: tgt.RcvType,
+				Name:    "Validate",
+				Misc:    make(map[string]interface{}),
+				Tmpl:    "validate",
+			}
+			tgt.MetaFile.Methods = append(tgt.MetaFile.Methods, validate)
+		}
+
+		fld := tgt.RcvName + "." + fldNm
+		for _, opt := range opts {
+			var cond, msg string
+			switch {
+			case opt == optRequired, opt == optNonZero:
+				var imp string
+				cond, imp = zeroCheck(fld, tgt.FldType)
+				if imp != "" {
+					tgt.MetaFile.Imports[imp] = struct{}{}
+				}
+				msg = "is required"
+				if opt == optNonZero {
+					msg = "must not be zero"
+				}
+			case strings.HasPrefix(opt, optMin), strings.HasPrefix(opt, optMax):
+				bound, op, word := strings.TrimPrefix(opt, optMin), "<", "least"
+				if strings.HasPrefix(opt, optMax) {
+					bound, op, word = strings.TrimPrefix(opt, optMax), ">", "most"
+				}
+				switch {
+				case k.hasLen():
+					cond = fmt.Sprintf("len(%s) %s %s", fld, op, bound)
+					msg = fmt.Sprintf("length must be at %s %s", word, bound)
+				case k.isNumeric():
+					cond = fmt.Sprintf("%s %s %s", fld, op, bound)
+					msg = fmt.Sprintf("must be at %s %s", word, bound)
+				}
+			case strings.HasPrefix(opt, optLen):
+				if k.hasLen() {
+					n := strings.TrimPrefix(opt, optLen)
+					cond = fmt.Sprintf("len(%s) != %s", fld, n)
+					msg = "length must be " + n
+				}
+			case strings.HasPrefix(opt, optOneOf):
+				vals := strings.Split(strings.TrimPrefix(opt, optOneOf), "|")
+				if k == kindString || k.isNumeric() {
+					neqs := make([]string, len(vals))
+					for i := range vals {
+						v := vals[i]
+						if k == kindString {
+							v = strconv.Quote(v)
+						}
+						neqs[i] = fmt.Sprintf("%s != %s", fld, v)
+					}
+					cond = strings.Join(neqs, " && ")
+					msg = "must be one of " + strings.Join(vals, ", ")
+				}
+			case strings.HasPrefix(opt, optRegexp):
+				if k == kindString {
+					pattern := strings.TrimPrefix(opt, optRegexp)
+					varNm := lowerFirst(strings.TrimPrefix(tgt.RcvType, "*")) + upperFirst(fldNm) + "Regexp"
+					addRegexp(tgt, varNm, pattern)
+					arg := fld
+					if isNamed {
+						arg = "string(" + fld + ")"
+					}
+					cond = fmt.Sprintf("!%s.MatchString(%s)", varNm, arg)
+					msg = "must match " + pattern
+				}
+			default:
+				continue
+			}
+			if cond == "" {
+				tgt.warnf("skipping 'validate,%s' - not supported for type %s", opt, tgt.FldType)
+				continue
+			}
+			checks = append(checks, fmt.Sprintf(
+				"if %s {\n\t\terrs = append(errs, errors.New(%s))\n\t}",
+				cond, strconv.Quote(fldNm+" "+msg),
+			))
+		}
+
+		if nested := nestedValidate(tgt, fld, fldNm); nested != "" {
+			checks = append(checks, nested)
+		}
+
+		validate.Misc["Checks"] = strings.Join(checks, "\n\t")
+	}
+}
+
+// nestedValidate returns code that validates a field whose type (or element type) has a Validate method.
+func nestedValidate(tgt *Target, fld, fldNm string) string {
+	const call = "if err := %s.Validate(); err != nil {\n" +
+		"%[2]s\terrs = append(errs, fmt.Errorf(%[3]s, %[4]serr))\n" +
+		"%[2]s}"
+
+	fldType := tgt.FldType
+	k := kindOf(fldType)
+	elemType := fldType
+	if k == kindPtr || k == kindSlice || k == kindArray {
+		elemType = elemOf(fldType)
+	}
+	if kindOf(elemType) != kindOther || !hasValidate(tgt, elemType) {
+		return ""
+	}
+
+	vlog.V(2).Printf("Adding import: \"fmt\"\n")
+	tgt.MetaFile.Imports["fmt"] = struct{}{}
+	switch k {
+	case kindOther:
+		return fmt.Sprintf(call, fld, "\t", strconv.Quote(fldNm+": %w"), "")
+	case kindPtr:
+		return fmt.Sprintf("if %s != nil {\n\t\t", fld) +
+			fmt.Sprintf(call, fld, "\t\t", strconv.Quote(fldNm+": %w"), "") +
+			"\n\t}"
+	default:
+		return fmt.Sprintf("for i := range %s {\n\t\t", fld) +
+			fmt.Sprintf(call, fld+"[i]", "\t\t", strconv.Quote(fldNm+"[%d]: %w"), "i, ") +
+			"\n\t}"
+	}
+}
+
+// hasValidate answers whether the given type has a Validate() error method, with a value or pointer receiver.
+func hasValidate(tgt *Target, typ string) bool {
+	t := lookupType(tgt, typ)
+	if t == nil {
+		return false
+	}
+	if sig := methodOf(tgt, t, "Validate"); sig != nil {
+		return sig.Params().Len() == 0 && sig.Results().Len() == 1 &&
+			types.Identical(sig.Results().At(0).Type(), types.Universe.Lookup("error").Type())
+	}
+	ok, _ := generatesMethod(tgt, t, "validate")
+	return ok
+}
+
+// addRegexp declares a package-level variable that holds the compiled pattern.
+func addRegexp(tgt *Target, name, pattern string) {
+	vlog.V(2).Printf("Adding import: \"regexp\"\n")
+	tgt.MetaFile.Imports["regexp"] = struct{}{}
+
+	vlog.V(2).Printf("Adding var: %s\n", name)
+	re := meta.Type{
+		Name: name,
+		Misc: map[string]interface{}{
+			"Pattern": strconv.Quote(pattern),
+		},
+		Tmpl: "regexp",
+	}
+	tgt.MetaFile.Types = append(tgt.MetaFile.Types, re)
+}
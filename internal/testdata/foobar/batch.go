@@ -0,0 +1,8 @@
+package foobar
+
+// Batch holds inputs that are expensive to process.
+type Batch struct {
+	inputs []string `meta:"mapper,int,parallel=4,err;mapper,float64,parallel,ctx,err;mapper,string,parallel;filter,parallel=2,ctx"`
+	lines  []string `meta:"mapper,int,err;mapper,float64,err,join;mapper,uint,parallel,err,join;filter,err,join"`
+	codes  []string `meta:"filter,ctx,err"`
+}
@@ -0,0 +1,148 @@
+package foobar
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBatch_MapInputsToInt(t *testing.T) {
+	b := Batch{inputs: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}
+	got, err := b.MapInputsToInt(strconv.Atoi)
+	if err != nil {
+		t.Fatalf("MapInputsToInt() error = %v", err)
+	}
+	if want := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MapInputsToInt() = %v, want %v", got, want)
+	}
+
+	b.inputs[4] = "five"
+	got, err = b.MapInputsToInt(strconv.Atoi)
+	var numErr *strconv.NumError
+	if got != nil || !errors.As(err, &numErr) || !strings.HasPrefix(err.Error(), "inputs[4]: ") {
+		t.Errorf("MapInputsToInt() = %v, %v, want nil, inputs[4]: ...", got, err)
+	}
+}
+
+func TestBatch_MapInputsToFloat64(t *testing.T) {
+	b := Batch{inputs: []string{"0.5", "1.5"}}
+	parse := func(ctx context.Context, s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	}
+	got, err := b.MapInputsToFloat64(context.Background(), parse)
+	if want := []float64{0.5, 1.5}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("MapInputsToFloat64() = %v, %v, want %v, nil", got, err, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := b.MapInputsToFloat64(ctx, parse); !errors.Is(err, context.Canceled) {
+		t.Errorf("MapInputsToFloat64() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestBatch_MapInputsToString(t *testing.T) {
+	b := Batch{inputs: []string{"a", "b", "c"}}
+	var calls int32
+	got := b.MapInputsToString(func(s string) string {
+		atomic.AddInt32(&calls, 1)
+		return strings.ToUpper(s)
+	})
+	if want := []string{"A", "B", "C"}; !reflect.DeepEqual(got, want) || calls != 3 {
+		t.Errorf("MapInputsToString() = %v (%d calls), want %v (3 calls)", got, calls, want)
+	}
+}
+
+func TestBatch_FilterInputs(t *testing.T) {
+	b := Batch{inputs: []string{"a", "bb", "c", "dd"}}
+	isMultiByte := func(ctx context.Context, s string) bool { return len(s) > 1 }
+	got, err := b.FilterInputs(context.Background(), isMultiByte)
+	if want := []string{"bb", "dd"}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterInputs() = %v, %v, want %v, nil", got, err, want)
+	}
+}
+
+func TestBatch_MapLinesToInt(t *testing.T) {
+	b := Batch{lines: []string{"1", "x", "3", "y"}}
+	got, err := b.MapLinesToInt(strconv.Atoi)
+	if got != nil || err == nil || !strings.HasPrefix(err.Error(), "lines[1]: ") {
+		t.Errorf("MapLinesToInt() = %v, %v, want nil, lines[1]: ...", got, err)
+	}
+}
+
+func TestBatch_MapLinesToFloat64(t *testing.T) {
+	b := Batch{lines: []string{"1", "x", "3", "y"}}
+	parse := func(s string) (float64, error) { return strconv.ParseFloat(s, 64) }
+	_, err := b.MapLinesToFloat64(parse)
+	if err == nil || !strings.Contains(err.Error(), "lines[1]: ") || !strings.Contains(err.Error(), "lines[3]: ") {
+		t.Errorf("MapLinesToFloat64() error = %v, want lines[1] and lines[3]", err)
+	}
+
+	b.lines = []string{"0.5", "1.5"}
+	got, err := b.MapLinesToFloat64(parse)
+	if want := []float64{0.5, 1.5}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("MapLinesToFloat64() = %v, %v, want %v, nil", got, err, want)
+	}
+}
+
+func TestBatch_MapLinesToUint(t *testing.T) {
+	b := Batch{lines: []string{"1", "-2", "3", "-4"}}
+	parse := func(s string) (uint, error) {
+		n, err := strconv.ParseUint(s, 10, 0)
+		return uint(n), err
+	}
+	_, err := b.MapLinesToUint(parse)
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) || !strings.Contains(err.Error(), "lines[1]: ") || !strings.Contains(err.Error(), "lines[3]: ") {
+		t.Errorf("MapLinesToUint() error = %v, want lines[1] and lines[3]", err)
+	}
+}
+
+func TestBatch_FilterLines(t *testing.T) {
+	b := Batch{lines: []string{"1", "22", "333"}}
+	isLong := func(s string) (bool, error) { return len(s) > 1, nil }
+	if got, err := b.FilterLines(isLong); err != nil || !reflect.DeepEqual(got, []string{"22", "333"}) {
+		t.Errorf("FilterLines() = %v, %v, want [22 333], nil", got, err)
+	}
+	if got, err := b.FilterLinesN(isLong, 1); err != nil || !reflect.DeepEqual(got, []string{"22"}) {
+		t.Errorf("FilterLinesN() = %v, %v, want [22], nil", got, err)
+	}
+
+	errOdd := errors.New("odd length")
+	isEven := func(s string) (bool, error) {
+		if len(s)%2 != 0 {
+			return false, errOdd
+		}
+		return true, nil
+	}
+	got, err := b.FilterLines(isEven)
+	if got != nil || !errors.Is(err, errOdd) || !strings.Contains(err.Error(), "lines[2]: ") {
+		t.Errorf("FilterLines() = %v, %v, want nil, lines[0] and lines[2]", got, err)
+	}
+}
+
+func TestBatch_FilterCodes(t *testing.T) {
+	b := Batch{codes: []string{"a", "b"}}
+	calls := 0
+	isA := func(ctx context.Context, s string) (bool, error) {
+		calls++
+		if s != "a" {
+			return false, errors.New("not a")
+		}
+		return true, nil
+	}
+	if _, err := b.FilterCodes(context.Background(), isA); err == nil || err.Error() != "codes[1]: not a" {
+		t.Errorf("FilterCodes() error = %v, want codes[1]: not a", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls = 0
+	if _, err := b.FilterCodes(ctx, isA); !errors.Is(err, context.Canceled) || calls != 0 {
+		t.Errorf("FilterCodes() error = %v (%d calls), want %v (0 calls)", err, calls, context.Canceled)
+	}
+}
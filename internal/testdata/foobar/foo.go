@@ -10,14 +10,27 @@ type Foo struct {
 	NoMetaJSON string       `json:"omitempty"`
 	name, Desc string       `meta:"new;getter;stringer"`
 	size       int          `meta:"stringer;ptr;getter;setter"`
-	labels     []string     `meta:"new;setter;getter;filter;mapper,time.Time"`
+	labels     []string     `meta:"new;setter;getter;filter;mapper,time.Time;clone;contains;index;distinct;partition;chunk;elems"`
 	stringer   fmt.Stringer `meta:"setter"`
 }
 
 type Bar struct {
 	name  string             `meta:"stringer;equal"`
-	foos  []Foo              `meta:"getter;setter;mapper,string"`
-	pairs map[string]float64 `meta:"getter;setter"`
-	times []time.Time        `meta:"getter;setter;filter;mapper,int64;equal,reflect"`
-	baz   bool               `meta:"setter"`
+	foos  []Foo              `meta:"getter;setter;mapper,string;clone;distinct,string;elems"`
+	pairs map[string]float64 `meta:"getter;setter;clone;iter,filter,map=string;lookup;put;delete;keys;values;filter;mapper,string"`
+	times []time.Time        `meta:"getter;setter;filter;mapper,int64;equal,reflect;clone;elems,singular=instant"`
+	baz   bool               `meta:"setter;clone,skip"`
+	owner *Foo               `meta:"clone"`
+	tree  map[string][]Foo   `meta:"clone"`
+	route Route              `meta:"clone"`
+}
+
+// Route clones itself using a pointer receiver.
+type Route struct {
+	stops []string
+}
+
+// Clone returns a deep copy of r.
+func (r *Route) Clone() *Route {
+	return &Route{stops: append([]string(nil), r.stops...)}
 }
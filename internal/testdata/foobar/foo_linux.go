@@ -0,0 +1,8 @@
+//go:build linux
+// +build linux
+
+package foobar
+
+type Baz struct {
+	fd int `meta:"getter"`
+}
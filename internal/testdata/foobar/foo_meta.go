@@ -1,12 +1,15 @@
-// GENERATED BY metatag, DO NOT EDIT
+// Code generated by metatag (devel) from foo.go; DO NOT EDIT.
 // (or edit away - I'm a comment, not a cop)
+// Inputs: sha256:328565e66eb7b8c824ded3be0fb85d884e7209a2505247715b1361bbd661db2f
 
 package foobar
 
 import (
+	"fmt"
+	"iter"
 	"reflect"
+	"sort"
 	"time"
-	"fmt"
 )
 
 // NewFoo creates a new Foo with the given initial values.
@@ -85,6 +88,141 @@ func (f Foo) MapLabelsToTime(fn func(string) time.Time) []time.Time {
 	return result
 }
 
+// Clone returns a deep copy of f.
+func (f Foo) Clone() Foo {
+	f2 := f
+	if f.labels != nil {
+		f2.labels = make([]string, len(f.labels))
+		copy(f2.labels, f.labels)
+	}
+	return f2
+}
+
+// ContainsLabels answers whether labels contains the given value.
+func (f Foo) ContainsLabels(v string) bool {
+	for i := range f.labels {
+		if f.labels[i] == v {
+			return true
+		}
+	}
+	return false
+}
+
+// IndexLabels returns the index of the first element of labels that is accepted by the given function,
+// or -1 if there is none.
+func (f Foo) IndexLabels(fn func(string) bool) int {
+	for i := range f.labels {
+		if fn(f.labels[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// DistinctLabels returns a copy of labels without duplicate elements.
+// The first occurrence of each element is kept, in order.
+func (f Foo) DistinctLabels() []string {
+	seen := make(map[string]struct{}, len(f.labels))
+	result := make([]string, 0, len(f.labels))
+	for i := range f.labels {
+		id := f.labels[i]
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			result = append(result, f.labels[i])
+		}
+	}
+	return result
+}
+
+// PartitionLabels splits labels into the elements that are accepted by the given function and the rest.
+// Both retain the original order.
+func (f Foo) PartitionLabels(fn func(string) bool) (matched, rest []string) {
+	var ms, rs []string
+	for i := range f.labels {
+		if fn(f.labels[i]) {
+			ms = append(ms, f.labels[i])
+		} else {
+			rs = append(rs, f.labels[i])
+		}
+	}
+	return ms, rs
+}
+
+// ChunkLabels splits labels into consecutive chunks of the given size; the last chunk may be smaller.
+// The chunks share the backing array of labels. A size < 1 results in a single chunk.
+func (f Foo) ChunkLabels(size int) [][]string {
+	if size < 1 {
+		size = len(f.labels)
+	}
+	var result [][]string
+	for i := 0; i < len(f.labels); i += size {
+		end := i + size
+		if end > len(f.labels) {
+			end = len(f.labels)
+		}
+		result = append(result, f.labels[i:end:end])
+	}
+	return result
+}
+
+// AddLabel appends the given values to labels.
+func (f *Foo) AddLabel(s ...string) {
+	f.labels = append(f.labels, s...)
+}
+
+// RemoveLabel removes all occurrences of the given value from labels, reusing its backing array.
+// It answers whether any were removed.
+func (f *Foo) RemoveLabel(s string) bool {
+	result := f.labels[:0]
+	for i := range f.labels {
+		if f.labels[i] != s {
+			result = append(result, f.labels[i])
+		}
+	}
+	if len(result) == len(f.labels) {
+		return false
+	}
+	var zero string
+	for i := len(result); i < len(f.labels); i++ {
+		f.labels[i] = zero
+	}
+	f.labels = result
+	return true
+}
+
+// LabelAt returns the element of labels at the given index, and whether the index is in range.
+func (f Foo) LabelAt(i int) (string, bool) {
+	if i < 0 || i >= len(f.labels) {
+		var zero string
+		return zero, false
+	}
+	return f.labels[i], true
+}
+
+// LabelsLen returns the number of elements of labels.
+func (f Foo) LabelsLen() int {
+	return len(f.labels)
+}
+
+// ClearLabels removes all elements from labels, retaining its capacity.
+func (f *Foo) ClearLabels() {
+	var zero string
+	for i := range f.labels {
+		f.labels[i] = zero
+	}
+	f.labels = f.labels[:0]
+}
+
+// ContainsLabel answers whether labels contains the given value.
+func (f Foo) ContainsLabel(s string) bool {
+	for i := range f.labels {
+		if f.labels[i] == s {
+			return true
+		}
+	}
+	return false
+}
+
 // SetStringer sets the given value as stringer.
 func (f *Foo) SetStringer(s fmt.Stringer) {
 	f.stringer = s
@@ -130,6 +268,93 @@ func (b Bar) MapFoosToString(fn func(Foo) string) []string {
 	return result
 }
 
+// Clone returns a deep copy of b.
+func (b Bar) Clone() Bar {
+	b2 := b
+	if b.foos != nil {
+		b2.foos = make([]Foo, len(b.foos))
+		copy(b2.foos, b.foos)
+		for i := range b.foos {
+			b2.foos[i] = b.foos[i].Clone()
+		}
+	}
+	if b.pairs != nil {
+		b2.pairs = make(map[string]float64, len(b.pairs))
+		for k, v := range b.pairs {
+			b2.pairs[k] = v
+		}
+	}
+	if b.times != nil {
+		b2.times = make([]time.Time, len(b.times))
+		copy(b2.times, b.times)
+	}
+	b2.baz = false
+	if b.owner != nil {
+		c := *b.owner
+		c = (*b.owner).Clone()
+		b2.owner = &c
+	}
+	if b.tree != nil {
+		b2.tree = make(map[string][]Foo, len(b.tree))
+		for k, v := range b.tree {
+			c := v
+			if v != nil {
+				c = make([]Foo, len(v))
+				copy(c, v)
+				for i1 := range v {
+					c[i1] = v[i1].Clone()
+				}
+			}
+			b2.tree[k] = c
+		}
+	}
+	b2.route = *b.route.Clone()
+	return b2
+}
+
+// DistinctFoos returns a copy of foos without duplicate elements, i.e. elements for which the given function returns the same key.
+// The first occurrence of each element is kept, in order.
+func (b Bar) DistinctFoos(key func(Foo) string) []Foo {
+	seen := make(map[string]struct{}, len(b.foos))
+	result := make([]Foo, 0, len(b.foos))
+	for i := range b.foos {
+		id := key(b.foos[i])
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			result = append(result, b.foos[i])
+		}
+	}
+	return result
+}
+
+// AddFoo appends the given values to foos.
+func (b *Bar) AddFoo(f ...Foo) {
+	b.foos = append(b.foos, f...)
+}
+
+// FooAt returns the element of foos at the given index, and whether the index is in range.
+func (b Bar) FooAt(i int) (Foo, bool) {
+	if i < 0 || i >= len(b.foos) {
+		var zero Foo
+		return zero, false
+	}
+	return b.foos[i], true
+}
+
+// FoosLen returns the number of elements of foos.
+func (b Bar) FoosLen() int {
+	return len(b.foos)
+}
+
+// ClearFoos removes all elements from foos, retaining its capacity.
+func (b *Bar) ClearFoos() {
+	var zero Foo
+	for i := range b.foos {
+		b.foos[i] = zero
+	}
+	b.foos = b.foos[:0]
+}
+
 // Pairs returns the value of pairs.
 func (b Bar) Pairs() map[string]float64 {
 	return b.pairs
@@ -140,6 +365,102 @@ func (b *Bar) SetPairs(m map[string]float64) {
 	b.pairs = m
 }
 
+// AllPairs returns an iterator over the key-value pairs of pairs, in unspecified order.
+func (b Bar) AllPairs() iter.Seq2[string, float64] {
+	return func(yield func(string, float64) bool) {
+		for k, v := range b.pairs {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// FilterPairsSeq returns an iterator over the key-value pairs of pairs that are accepted by the given function, in unspecified order.
+func (b Bar) FilterPairsSeq(fn func(string, float64) bool) iter.Seq2[string, float64] {
+	return func(yield func(string, float64) bool) {
+		for k, v := range b.pairs {
+			if fn(k, v) && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// MapPairsToStringSeq returns an iterator over the keys of pairs and the results of calling the given function for each key-value pair, in unspecified order.
+func (b Bar) MapPairsToStringSeq(fn func(string, float64) string) iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		for k, v := range b.pairs {
+			if !yield(k, fn(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// GetPairsValue returns the value stored in pairs under the given key, and whether there is one.
+func (b Bar) GetPairsValue(key string) (float64, bool) {
+	value, ok := b.pairs[key]
+	return value, ok
+}
+
+// PutPairs stores the given value in pairs under the given key, making pairs if necessary.
+func (b *Bar) PutPairs(key string, value float64) {
+	if b.pairs == nil {
+		b.pairs = make(map[string]float64)
+	}
+	b.pairs[key] = value
+}
+
+// DeletePairs deletes the value stored in pairs under the given key, if any.
+func (b Bar) DeletePairs(key string) {
+	delete(b.pairs, key)
+}
+
+// PairsKeys returns the keys of pairs in ascending order.
+func (b Bar) PairsKeys() []string {
+	keys := make([]string, 0, len(b.pairs))
+	for key := range b.pairs {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// PairsValues returns the values of pairs in ascending order of their keys.
+func (b Bar) PairsValues() []float64 {
+	values := make([]float64, 0, len(b.pairs))
+	keys := make([]string, 0, len(b.pairs))
+	for key := range b.pairs {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	for _, key := range keys {
+		values = append(values, b.pairs[key])
+	}
+	return values
+}
+
+// FilterPairs returns a copy of pairs, omitting entries that are rejected by the given function.
+func (b Bar) FilterPairs(fn func(string, float64) bool) map[string]float64 {
+	result := make(map[string]float64)
+	for key, value := range b.pairs {
+		if fn(key, value) {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// MapPairsValues returns a new map with the results of calling the given function for each value of pairs, under the same keys.
+func (b Bar) MapPairsValues(fn func(float64) string) map[string]string {
+	result := make(map[string]string, len(b.pairs))
+	for key, value := range b.pairs {
+		result[key] = fn(value)
+	}
+	return result
+}
+
 // Times returns the value of times.
 func (b Bar) Times() []time.Time {
 	return b.times
@@ -182,6 +503,64 @@ func (b Bar) MapTimesToInt64(fn func(time.Time) int64) []int64 {
 	return result
 }
 
+// AddInstant appends the given values to times.
+func (b *Bar) AddInstant(t ...time.Time) {
+	b.times = append(b.times, t...)
+}
+
+// RemoveInstant removes all occurrences of the given value from times, reusing its backing array.
+// It answers whether any were removed.
+func (b *Bar) RemoveInstant(t time.Time) bool {
+	result := b.times[:0]
+	for i := range b.times {
+		if b.times[i] != t {
+			result = append(result, b.times[i])
+		}
+	}
+	if len(result) == len(b.times) {
+		return false
+	}
+	var zero time.Time
+	for i := len(result); i < len(b.times); i++ {
+		b.times[i] = zero
+	}
+	b.times = result
+	return true
+}
+
+// InstantAt returns the element of times at the given index, and whether the index is in range.
+func (b Bar) InstantAt(i int) (time.Time, bool) {
+	if i < 0 || i >= len(b.times) {
+		var zero time.Time
+		return zero, false
+	}
+	return b.times[i], true
+}
+
+// TimesLen returns the number of elements of times.
+func (b Bar) TimesLen() int {
+	return len(b.times)
+}
+
+// ClearTimes removes all elements from times, retaining its capacity.
+func (b *Bar) ClearTimes() {
+	var zero time.Time
+	for i := range b.times {
+		b.times[i] = zero
+	}
+	b.times = b.times[:0]
+}
+
+// ContainsInstant answers whether times contains the given value.
+func (b Bar) ContainsInstant(t time.Time) bool {
+	for i := range b.times {
+		if b.times[i] == t {
+			return true
+		}
+	}
+	return false
+}
+
 // SetBaz sets the given value as baz.
 func (b *Bar) SetBaz(bb bool) {
 	b.baz = bb
@@ -0,0 +1,13 @@
+// Code generated by metatag (devel) from foo_linux.go; DO NOT EDIT.
+// (or edit away - I'm a comment, not a cop)
+// Inputs: sha256:61afef527f124b3e0d1b1153e1922164f7f959577f7e860682fbc87d832df927
+
+//go:build linux
+// +build linux
+
+package foobar
+
+// Fd returns the value of fd.
+func (b Baz) Fd() int {
+	return b.fd
+}
@@ -0,0 +1,10 @@
+package foobar
+
+import "testing"
+
+func TestBaz_Fd(t *testing.T) {
+	b := Baz{fd: 3}
+	if got := b.Fd(); got != b.fd {
+		t.Errorf("Fd() = %v, want %v", got, b.fd)
+	}
+}
@@ -2,6 +2,7 @@ package foobar
 
 import (
 	"reflect"
+	"strconv"
 	"testing"
 	"time"
 )
@@ -49,6 +50,180 @@ func TestFoo_FilterLabels(t *testing.T) {
 	}
 }
 
+func TestFoo_ContainsLabels(t *testing.T) {
+	f := Foo{labels: []string{"a", "b"}}
+	if !f.ContainsLabels("b") || f.ContainsLabels("c") {
+		t.Errorf("ContainsLabels() = wrong result")
+	}
+}
+
+func TestFoo_IndexLabels(t *testing.T) {
+	f := Foo{labels: []string{"a", "aa", "b", "bb"}}
+	isMultiByte := func(s string) bool { return len(s) > 1 }
+	if got := f.IndexLabels(isMultiByte); got != 1 {
+		t.Errorf("IndexLabels() = %v, want %v", got, 1)
+	}
+}
+
+func TestFoo_DistinctLabels(t *testing.T) {
+	f := Foo{labels: []string{"b", "a", "b", "c", "a"}}
+	want := []string{"b", "a", "c"}
+	if got := f.DistinctLabels(); !reflect.DeepEqual(got, want) {
+		t.Errorf("DistinctLabels() = %v, want %v", got, want)
+	}
+}
+
+func TestFoo_PartitionLabels(t *testing.T) {
+	f := Foo{labels: []string{"a", "aa", "b", "bb"}}
+	isMultiByte := func(s string) bool { return len(s) > 1 }
+	matched, rest := f.PartitionLabels(isMultiByte)
+	if !reflect.DeepEqual(matched, []string{"aa", "bb"}) || !reflect.DeepEqual(rest, []string{"a", "b"}) {
+		t.Errorf("PartitionLabels() = %v, %v, want [aa bb], [a b]", matched, rest)
+	}
+}
+
+func TestFoo_ChunkLabels(t *testing.T) {
+	f := Foo{labels: []string{"a", "b", "c", "d", "e"}}
+	want := [][]string{{"a", "b"}, {"c", "d"}, {"e"}}
+	got := f.ChunkLabels(2)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ChunkLabels() = %v, want %v", got, want)
+	}
+	// appending to a chunk must not overwrite the next one
+	_ = append(got[0], "x")
+	if f.labels[2] != "c" {
+		t.Errorf("append() to chunk overwrote %v", f.labels)
+	}
+	if got := len(f.ChunkLabels(0)); got != 1 {
+		t.Errorf("len(ChunkLabels(0)) = %v, want 1", got)
+	}
+}
+
+func TestFoo_Labels(t *testing.T) {
+	var f Foo
+	f.AddLabel("a", "b")
+	f.AddLabel("a")
+	if got := f.LabelsLen(); got != 3 {
+		t.Errorf("LabelsLen() = %v, want 3", got)
+	}
+	if got, ok := f.LabelAt(1); !ok || got != "b" {
+		t.Errorf("LabelAt(1) = %v, %v, want b, true", got, ok)
+	}
+	if _, ok := f.LabelAt(3); ok {
+		t.Errorf("LabelAt(3) = _, true, want false")
+	}
+	if _, ok := f.LabelAt(-1); ok {
+		t.Errorf("LabelAt(-1) = _, true, want false")
+	}
+
+	if !f.RemoveLabel("a") || !reflect.DeepEqual(f.labels, []string{"b"}) {
+		t.Errorf("RemoveLabel() left %v, want [b]", f.labels)
+	}
+	if f.RemoveLabel("a") || f.ContainsLabel("a") || !f.ContainsLabel("b") {
+		t.Errorf("RemoveLabel()/ContainsLabel() = wrong result")
+	}
+
+	backing := f.labels[:cap(f.labels)]
+	f.ClearLabels()
+	if f.LabelsLen() != 0 || cap(f.labels) != len(backing) || backing[0] != "" {
+		t.Errorf("ClearLabels() left %v (backing %v)", f.labels, backing)
+	}
+}
+
+func TestBar_Elems(t *testing.T) {
+	var b Bar
+	b.AddFoo(Foo{name: "a"}, Foo{name: "b"})
+	if got, ok := b.FooAt(1); !ok || got.name != "b" || b.FoosLen() != 2 {
+		t.Errorf("FooAt(1) = %v, %v, want b, true", got, ok)
+	}
+	b.ClearFoos()
+	if b.FoosLen() != 0 {
+		t.Errorf("ClearFoos() left %v", b.foos)
+	}
+
+	b.AddInstant(time.Unix(1, 0), time.Unix(2, 0))
+	if !b.ContainsInstant(time.Unix(2, 0)) || !b.RemoveInstant(time.Unix(1, 0)) || b.TimesLen() != 1 {
+		t.Errorf("AddInstant()/RemoveInstant() left %v", b.times)
+	}
+	if got, ok := b.InstantAt(0); !ok || !got.Equal(time.Unix(2, 0)) {
+		t.Errorf("InstantAt(0) = %v, %v, want %v, true", got, ok, time.Unix(2, 0))
+	}
+	b.ClearTimes()
+	if b.TimesLen() != 0 {
+		t.Errorf("ClearTimes() left %v", b.times)
+	}
+}
+
+func TestBar_DistinctFoos(t *testing.T) {
+	b := Bar{foos: []Foo{{name: "a", size: 1}, {name: "b"}, {name: "a", size: 2}}}
+	got := b.DistinctFoos(func(f Foo) string { return f.name })
+	if len(got) != 2 || got[0].size != 1 || got[1].name != "b" {
+		t.Errorf("DistinctFoos() = %v, want [{a 1} {b 0}]", got)
+	}
+}
+
+func TestBar_AllPairs(t *testing.T) {
+	b := Bar{pairs: map[string]float64{"a": 1, "b": 2, "c": 3}}
+	got := make(map[string]float64)
+	for k, v := range b.AllPairs() {
+		got[k] = v
+	}
+	if !reflect.DeepEqual(got, b.pairs) {
+		t.Errorf("AllPairs() = %v, want %v", got, b.pairs)
+	}
+
+	got = make(map[string]float64)
+	for k, v := range b.FilterPairsSeq(func(k string, v float64) bool { return v > 1 }) {
+		got[k] = v
+	}
+	if want := map[string]float64{"b": 2, "c": 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterPairsSeq() = %v, want %v", got, want)
+	}
+
+	for k, v := range b.MapPairsToStringSeq(func(k string, v float64) string { return k + k }) {
+		if v != k+k {
+			t.Errorf("MapPairsToStringSeq() = %v: %v, want %v", k, v, k+k)
+		}
+	}
+}
+
+func TestBar_Pairs(t *testing.T) {
+	var b Bar
+	if _, ok := b.GetPairsValue("a"); ok {
+		t.Errorf("GetPairsValue() of nil map = _, true, want false")
+	}
+	b.PutPairs("c", 3)
+	b.PutPairs("a", 1)
+	b.PutPairs("b", 2)
+	if v, ok := b.GetPairsValue("a"); !ok || v != 1 {
+		t.Errorf("GetPairsValue() = %v, %v, want 1, true", v, ok)
+	}
+	if got, want := b.PairsKeys(), []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PairsKeys() = %v, want %v", got, want)
+	}
+	if got, want := b.PairsValues(), []float64{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PairsValues() = %v, want %v", got, want)
+	}
+
+	got := b.FilterPairs(func(k string, v float64) bool { return v > 1 })
+	if want := map[string]float64{"b": 2, "c": 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterPairs() = %v, want %v", got, want)
+	}
+	if len(b.pairs) != 3 {
+		t.Errorf("FilterPairs() modified pairs: %v", b.pairs)
+	}
+
+	strs := b.MapPairsValues(func(v float64) string { return strconv.FormatFloat(v, 'f', 1, 64) })
+	if want := map[string]string{"a": "1.0", "b": "2.0", "c": "3.0"}; !reflect.DeepEqual(strs, want) {
+		t.Errorf("MapPairsValues() = %v, want %v", strs, want)
+	}
+
+	b.DeletePairs("b")
+	if got, want := b.PairsKeys(), []string{"a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DeletePairs() left %v, want %v", got, want)
+	}
+}
+
 func TestBar_MapTimesToInt64(t *testing.T) {
 	b := Bar{times: []time.Time{time.Unix(1, 0), time.Unix(2, 0)}}
 	want := []int64{1, 2}
@@ -69,3 +244,35 @@ func TestBar_MapFoosToString(t *testing.T) {
 		t.Errorf("Bar.MapFoosToString() = %v, want %v", got, want)
 	}
 }
+
+func TestBar_Clone(t *testing.T) {
+	b := Bar{
+		name:  "bar",
+		foos:  []Foo{{name: "a", labels: []string{"x"}}},
+		pairs: map[string]float64{"pi": 3.14},
+		times: []time.Time{time.Unix(1, 0)},
+		baz:   true,
+		owner: &Foo{name: "owner", labels: []string{"y"}},
+		tree:  map[string][]Foo{"t": {{labels: []string{"z"}}}},
+		route: Route{stops: []string{"s"}},
+	}
+	c := b.Clone()
+	if c.baz {
+		t.Errorf("Clone().baz = %v, want %v", c.baz, false)
+	}
+	c.baz = b.baz
+	if !reflect.DeepEqual(c, b) {
+		t.Fatalf("Clone() = %v, want %v", c, b)
+	}
+
+	c.foos[0].labels[0] = "changed"
+	c.pairs["pi"] = 3
+	c.times[0] = time.Unix(2, 0)
+	c.owner.labels[0] = "changed"
+	c.tree["t"][0].labels[0] = "changed"
+	c.route.stops[0] = "changed"
+	if b.foos[0].labels[0] != "x" || b.pairs["pi"] != 3.14 || !b.times[0].Equal(time.Unix(1, 0)) ||
+		b.owner.labels[0] != "y" || b.tree["t"][0].labels[0] != "z" || b.route.stops[0] != "s" {
+		t.Errorf("Clone() shares storage with the original: %v", b)
+	}
+}
@@ -0,0 +1,14 @@
+package foobar
+
+// Item has the receiver name i, which generated code must not shadow with its loop variables.
+type Item struct {
+	tags []string         `meta:"equal;hash;compare;clone"`
+	cube [][][]int        `meta:"equal;hash;clone"`
+	meta map[string][]int `meta:"equal;hash;clone"`
+}
+
+// Config has the receiver name c, which generated code must not shadow with its copies.
+type Config struct {
+	parent *Config             `meta:"clone"`
+	env    map[string][]string `meta:"clone"`
+}
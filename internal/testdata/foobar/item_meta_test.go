@@ -0,0 +1,94 @@
+package foobar
+
+import "testing"
+
+func TestItem_Equal(t *testing.T) {
+	newItem := func() Item {
+		return Item{
+			tags: []string{"a", "b"},
+			cube: [][][]int{{{1, 2}, {3}}},
+			meta: map[string][]int{"k": {1}},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*Item)
+		want   bool
+	}{
+		{name: "same", modify: func(i *Item) {}, want: true},
+		{name: "tags", modify: func(i *Item) { i.tags[1] = "c" }, want: false},
+		{name: "cube", modify: func(i *Item) { i.cube[0][1][0] = 4 }, want: false},
+		{name: "meta", modify: func(i *Item) { i.meta["k"] = []int{2} }, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i, i2 := newItem(), newItem()
+			tt.modify(&i2)
+			if got := i.Equal(i2); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestItem_Hash(t *testing.T) {
+	i := Item{
+		tags: []string{"a", "b"},
+		cube: [][][]int{{{1, 2}, {3}}},
+		meta: map[string][]int{"k": {1}, "l": nil},
+	}
+	i2 := Item{
+		tags: []string{"a", "b"},
+		cube: [][][]int{{{1, 2}, {3}}},
+		meta: map[string][]int{"l": nil, "k": {1}},
+	}
+	if i.Hash() != i2.Hash() {
+		t.Errorf("Hash() = %v, want %v", i.Hash(), i2.Hash())
+	}
+	i2.cube[0][1][0] = 4
+	if i.Hash() == i2.Hash() {
+		t.Errorf("Hash() = %v for different items", i.Hash())
+	}
+}
+
+func TestItem_Compare(t *testing.T) {
+	i, i2 := Item{tags: []string{"a", "b"}}, Item{tags: []string{"a", "c"}}
+	if got := i.Compare(i2); got != -1 {
+		t.Errorf("Compare() = %v, want %v", got, -1)
+	}
+	if got := i.Compare(i); got != 0 {
+		t.Errorf("Compare() = %v, want %v", got, 0)
+	}
+}
+
+func TestItem_Clone(t *testing.T) {
+	i := Item{
+		tags: []string{"a"},
+		cube: [][][]int{{{1}}},
+		meta: map[string][]int{"k": {1}},
+	}
+	i2 := i.Clone()
+	if !i.Equal(i2) {
+		t.Fatalf("Clone() = %v, want %v", i2, i)
+	}
+	i2.tags[0], i2.cube[0][0][0], i2.meta["k"][0] = "b", 2, 2
+	if i.tags[0] != "a" || i.cube[0][0][0] != 1 || i.meta["k"][0] != 1 {
+		t.Errorf("Clone() shares memory with the original: %v", i)
+	}
+}
+
+func TestConfig_Clone(t *testing.T) {
+	c := Config{
+		parent: &Config{env: map[string][]string{"PATH": {"/bin"}}},
+		env:    map[string][]string{"HOME": {"/root"}},
+	}
+	c2 := c.Clone()
+	if c2.parent == c.parent {
+		t.Fatalf("Clone() shares parent with the original")
+	}
+	c2.parent.env["PATH"][0], c2.env["HOME"][0] = "/usr/bin", "/home"
+	if c.parent.env["PATH"][0] != "/bin" || c.env["HOME"][0] != "/root" {
+		t.Errorf("Clone() shares memory with the original: %v", c)
+	}
+}
@@ -0,0 +1,44 @@
+package foobar
+
+import "time"
+
+type Level int
+
+// Version compares using its own Compare method, which has a pointer receiver.
+type Version struct {
+	major, minor int
+}
+
+// Compare returns -1 if v is older than v2, 0 if they are the same and +1 if v is newer than v2.
+func (v *Version) Compare(v2 *Version) int {
+	switch {
+	case v.major != v2.major:
+		return compareInts(v.major, v2.major)
+	default:
+		return compareInts(v.minor, v2.minor)
+	}
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Job orders jobs from highest to lowest level, then from shortest to longest timeout.
+type Job struct {
+	level   Level         `meta:"compare,1,desc"`
+	timeout time.Duration `meta:"compare,2"`
+	version Version       `meta:"compare"`
+	started time.Time     `meta:"compare"`
+}
+
+// Node has the receiver name n, which generated code must not shadow.
+type Node struct {
+	version Version `meta:"compare"`
+	path    []int   `meta:"compare"`
+}
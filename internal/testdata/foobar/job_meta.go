@@ -0,0 +1,52 @@
+// Code generated by metatag (devel) from job.go; DO NOT EDIT.
+// (or edit away - I'm a comment, not a cop)
+// Inputs: sha256:d193884499570c3a77c88f0fa4956575275d8a76e97a5f2f1b16df6ab18d4120
+
+package foobar
+
+// Compare returns -1 if j is less than j2,
+// 0 if they are equal and +1 if j is greater than j2.
+func (j Job) Compare(j2 Job) int {
+	switch {
+	case j.level < j2.level:
+		return +1
+	case j.level > j2.level:
+		return -1
+	}
+	switch {
+	case j.timeout < j2.timeout:
+		return -1
+	case j.timeout > j2.timeout:
+		return +1
+	}
+	if n := j.version.Compare(&j2.version); n != 0 {
+		return n
+	}
+	if n := j.started.Compare(j2.started); n != 0 {
+		return n
+	}
+	return 0
+}
+
+// Compare returns -1 if n is less than n2,
+// 0 if they are equal and +1 if n is greater than n2.
+func (n Node) Compare(n2 Node) int {
+	if n_ := n.version.Compare(&n2.version); n_ != 0 {
+		return n_
+	}
+	for i := 0; i < len(n.path) && i < len(n2.path); i++ {
+		switch {
+		case n.path[i] < n2.path[i]:
+			return -1
+		case n.path[i] > n2.path[i]:
+			return +1
+		}
+	}
+	switch {
+	case len(n.path) < len(n2.path):
+		return -1
+	case len(n.path) > len(n2.path):
+		return +1
+	}
+	return 0
+}
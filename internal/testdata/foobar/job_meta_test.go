@@ -0,0 +1,51 @@
+package foobar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJob_Compare(t *testing.T) {
+	tests := []struct {
+		name string
+		j    Job
+		j2   Job
+		want int
+	}{
+		{name: "same", j: Job{level: 1}, j2: Job{level: 1}, want: 0},
+		{name: "higher level first", j: Job{level: 10}, j2: Job{level: 9}, want: -1},
+		{name: "lower level last", j: Job{level: 9}, j2: Job{level: 10}, want: +1},
+		{name: "shorter timeout first", j: Job{timeout: 30 * time.Second}, j2: Job{timeout: time.Minute}, want: -1},
+		{name: "longer timeout last", j: Job{timeout: time.Minute}, j2: Job{timeout: 30 * time.Second}, want: +1},
+		{name: "older version first", j: Job{version: Version{1, 9}}, j2: Job{version: Version{1, 10}}, want: -1},
+		{name: "earlier start first", j: Job{started: time.Unix(1, 0)}, j2: Job{started: time.Unix(2, 0)}, want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.j.Compare(tt.j2); got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNode_Compare(t *testing.T) {
+	tests := []struct {
+		name string
+		n    Node
+		n2   Node
+		want int
+	}{
+		{name: "same", n: Node{path: []int{1, 2}}, n2: Node{path: []int{1, 2}}, want: 0},
+		{name: "older version first", n: Node{version: Version{1, 0}}, n2: Node{version: Version{2, 0}}, want: -1},
+		{name: "shorter path first", n: Node{path: []int{1}}, n2: Node{path: []int{1, 2}}, want: -1},
+		{name: "greater path last", n: Node{path: []int{2}}, n2: Node{path: []int{1, 2}}, want: +1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.n.Compare(tt.n2); got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
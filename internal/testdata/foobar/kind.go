@@ -0,0 +1,12 @@
+package foobar
+
+// Kind is indexed by a field whose lowercase name is a keyword.
+type Kind struct {
+	Type string
+	Name string
+}
+
+// Kinds is a collection of Kind.
+type Kinds struct {
+	ks []Kind `meta:"index,by=Type"`
+}
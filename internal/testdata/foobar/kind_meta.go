@@ -0,0 +1,29 @@
+// Code generated by metatag (devel) from kind.go; DO NOT EDIT.
+// (or edit away - I'm a comment, not a cop)
+// Inputs: sha256:50ea6fcdcbdb8cb5c553b96684fa9afd1a2da849c7b7ab314377488bbb5265f0
+
+package foobar
+
+// KsByType returns the elements of ks by their Type.
+// If several elements have the same Type, the first one is used.
+func (k Kinds) KsByType() map[string]Kind {
+	result := make(map[string]Kind, len(k.ks))
+	for i := range k.ks {
+		if _, ok := result[k.ks[i].Type]; !ok {
+			result[k.ks[i].Type] = k.ks[i]
+		}
+	}
+	return result
+}
+
+// FindKsByType returns the first element of ks with the given Type
+// and answers whether there is one.
+func (k Kinds) FindKsByType(key string) (Kind, bool) {
+	for i := range k.ks {
+		if k.ks[i].Type == key {
+			return k.ks[i], true
+		}
+	}
+	var zero Kind
+	return zero, false
+}
@@ -0,0 +1,13 @@
+package foobar
+
+import "testing"
+
+func TestKinds_FindKsByType(t *testing.T) {
+	k := Kinds{ks: []Kind{{Type: "a", Name: "first"}, {Type: "b"}, {Type: "a", Name: "second"}}}
+	if got, ok := k.FindKsByType("a"); !ok || got.Name != "first" {
+		t.Errorf("FindKsByType() = %v, %v, want %v, %v", got, ok, "first", true)
+	}
+	if _, ok := k.FindKsByType("c"); ok {
+		t.Errorf("FindKsByType() = %v, want %v", ok, false)
+	}
+}
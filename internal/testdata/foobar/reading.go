@@ -0,0 +1,12 @@
+package foobar
+
+type Celsius float64
+
+// Readings are ordered from warmest to coldest.
+type Readings struct {
+	temps []Celsius `meta:"wrapper;new;sort,natural,desc;search;reduce,max,avg"`
+}
+
+type Scores struct {
+	scores []int `meta:"wrapper;new;sort,natural,stable;search;reduce,string,sum,min,max,avg;filter,inplace"`
+}
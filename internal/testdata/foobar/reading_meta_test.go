@@ -0,0 +1,145 @@
+package foobar
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestReadings_Sort(t *testing.T) {
+	r := NewReadings([]Celsius{12.5, -3, 30, 0}).Sort()
+	want := []Celsius{30, 12.5, 0, -3}
+	if !reflect.DeepEqual(r.temps, want) {
+		t.Errorf("got = %v, want %v", r.temps, want)
+	}
+	if !r.IsSorted() {
+		t.Errorf("IsSorted() = false, want true")
+	}
+
+	// NaN sorts first in ascending order, hence last in descending order
+	r = NewReadings([]Celsius{Celsius(math.NaN()), 1, 2}).Sort()
+	if r.temps[0] != 2 || r.temps[1] != 1 || !math.IsNaN(float64(r.temps[2])) {
+		t.Errorf("got = %v, want [2 1 NaN]", r.temps)
+	}
+}
+
+func TestScores_Sort(t *testing.T) {
+	s := NewScores([]int{3, 1, 2})
+	if s.IsSorted() {
+		t.Errorf("IsSorted() = true, want false")
+	}
+	s.Sort()
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(s.scores, want) {
+		t.Errorf("got = %v, want %v", s.scores, want)
+	}
+	if !s.IsSorted() {
+		t.Errorf("IsSorted() = false, want true")
+	}
+}
+
+func TestScores_Search(t *testing.T) {
+	s := NewScores([]int{1, 3, 5})
+	tests := []struct {
+		v      int
+		want   int
+		wantOK bool
+	}{
+		{v: 0, want: 0, wantOK: false},
+		{v: 3, want: 1, wantOK: true},
+		{v: 4, want: 2, wantOK: false},
+		{v: 6, want: 3, wantOK: false},
+	}
+	for _, tt := range tests {
+		if got, ok := s.Search(tt.v); got != tt.want || ok != tt.wantOK {
+			t.Errorf("Search(%v) = %v, %v, want %v, %v", tt.v, got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	s.InsertSorted(4)
+	s.InsertSorted(0)
+	s.InsertSorted(6)
+	if want := []int{0, 1, 3, 4, 5, 6}; !reflect.DeepEqual(s.scores, want) {
+		t.Errorf("InsertSorted() got = %v, want %v", s.scores, want)
+	}
+	if !s.RemoveSorted(3) || s.RemoveSorted(2) {
+		t.Errorf("RemoveSorted() = false, want true only for present values")
+	}
+	if want := []int{0, 1, 4, 5, 6}; !reflect.DeepEqual(s.scores, want) {
+		t.Errorf("RemoveSorted() got = %v, want %v", s.scores, want)
+	}
+	if vacated := s.scores[:len(s.scores)+1][len(s.scores)]; vacated != 0 {
+		t.Errorf("RemoveSorted() left %v in the backing array, want 0", vacated)
+	}
+}
+
+func TestReadings_Search(t *testing.T) {
+	r := NewReadings([]Celsius{30, 12.5, -3})
+	if i, ok := r.Search(12.5); i != 1 || !ok {
+		t.Errorf("Search(12.5) = %v, %v, want 1, true", i, ok)
+	}
+	r.InsertSorted(20)
+	if want := []Celsius{30, 20, 12.5, -3}; !reflect.DeepEqual(r.temps, want) {
+		t.Errorf("InsertSorted() got = %v, want %v", r.temps, want)
+	}
+	if !r.IsSorted() {
+		t.Errorf("IsSorted() = false, want true")
+	}
+}
+
+func TestScores_Reduce(t *testing.T) {
+	s := NewScores([]int{3, 1, 2})
+	got := s.ReduceToString("", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+	if want := "312"; got != want {
+		t.Errorf("ReduceToString() = %v, want %v", got, want)
+	}
+	if got := s.Sum(); got != 6 {
+		t.Errorf("Sum() = %v, want 6", got)
+	}
+	if got, ok := s.Min(); got != 1 || !ok {
+		t.Errorf("Min() = %v, %v, want 1, true", got, ok)
+	}
+	if got, ok := s.Max(); got != 3 || !ok {
+		t.Errorf("Max() = %v, %v, want 3, true", got, ok)
+	}
+	if got := s.Avg(); got != 2 {
+		t.Errorf("Avg() = %v, want 2", got)
+	}
+
+	empty := NewScores(nil)
+	if _, ok := empty.Min(); ok {
+		t.Errorf("Min() of empty = _, true, want false")
+	}
+	if got := empty.Sum() + int(empty.Avg()); got != 0 {
+		t.Errorf("Sum() + Avg() of empty = %v, want 0", got)
+	}
+}
+
+func TestReadings_Reduce(t *testing.T) {
+	r := NewReadings([]Celsius{-3, 12.5, 0.5})
+	if got, ok := r.Max(); got != 12.5 || !ok {
+		t.Errorf("Max() = %v, %v, want 12.5, true", got, ok)
+	}
+	if got := r.Avg(); got != 10.0/3 {
+		t.Errorf("Avg() = %v, want %v", got, 10.0/3)
+	}
+}
+
+func TestScores_Filter(t *testing.T) {
+	isPass := func(v int) bool { return v >= 50 }
+	s := NewScores([]int{70, 20, 90, 40}).Filter(isPass).Sort()
+	if want := []int{70, 90}; !reflect.DeepEqual(s.scores, want) {
+		t.Errorf("Filter() = %v, want %v", s.scores, want)
+	}
+
+	s = NewScores([]int{70, 20, 90, 40})
+	s.Reject(isPass)
+	if want := []int{20, 40}; !reflect.DeepEqual(s.scores, want) {
+		t.Errorf("Reject() = %v, want %v", s.scores, want)
+	}
+	s.Retain(isPass)
+	if len(s.scores) != 0 {
+		t.Errorf("Retain() = %v, want []", s.scores)
+	}
+}
@@ -0,0 +1,50 @@
+package foobar
+
+import (
+	"errors"
+	"time"
+)
+
+type Request struct {
+	ID      string   `meta:"validate,required,regexp=^[a-z0-9-]+$"`
+	Method  string   `meta:"validate,oneof=GET|POST"`
+	Retries int      `meta:"validate,min=0,max=5"`
+	Tags    []string `meta:"validate,max=3"`
+	Code    string   `meta:"validate,len=2"`
+	Parts   []Part   `meta:"validate"`
+	Owner   *Part    `meta:"validate"`
+	Limits  Limits   `meta:"validate"`
+}
+
+type Part struct {
+	Name string `meta:"validate,nonzero"`
+}
+
+var errNegativeMax = errors.New("max must not be negative")
+
+// Limits validates itself using a pointer receiver.
+type Limits struct {
+	Max int
+}
+
+// Validate returns an error if l is invalid.
+func (l *Limits) Validate() error {
+	if l.Max < 0 {
+		return errNegativeMax
+	}
+	return nil
+}
+
+// Endpoint is validated using the underlying types of its fields.
+type Endpoint struct {
+	Scheme  Scheme        `meta:"validate,oneof=http|https"`
+	Host    Hostname      `meta:"validate,regexp=^[a-z.]+$"`
+	Port    Port          `meta:"validate,min=1,max=65535"`
+	Timeout time.Duration `meta:"validate,max=time.Minute"`
+}
+
+type (
+	Scheme   string
+	Hostname string
+	Port     int
+)
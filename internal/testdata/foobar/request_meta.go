@@ -0,0 +1,89 @@
+// Code generated by metatag (devel) from request.go; DO NOT EDIT.
+// (or edit away - I'm a comment, not a cop)
+// Inputs: sha256:f956ff97704a7f1f9868cfa98ab35d3abcf491d069eeed78d209423bd9a0562f
+
+package foobar
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+var requestIDRegexp = regexp.MustCompile("^[a-z0-9-]+$")
+
+var endpointHostRegexp = regexp.MustCompile("^[a-z.]+$")
+
+// Validate answers whether r satisfies the constraints of its fields.
+// Returns an error that joins every violation, or nil if there are none.
+func (r Request) Validate() error {
+	var errs []error
+	if r.ID == "" {
+		errs = append(errs, errors.New("ID is required"))
+	}
+	if !requestIDRegexp.MatchString(r.ID) {
+		errs = append(errs, errors.New("ID must match ^[a-z0-9-]+$"))
+	}
+	if r.Method != "GET" && r.Method != "POST" {
+		errs = append(errs, errors.New("Method must be one of GET, POST"))
+	}
+	if r.Retries < 0 {
+		errs = append(errs, errors.New("Retries must be at least 0"))
+	}
+	if r.Retries > 5 {
+		errs = append(errs, errors.New("Retries must be at most 5"))
+	}
+	if len(r.Tags) > 3 {
+		errs = append(errs, errors.New("Tags length must be at most 3"))
+	}
+	if len(r.Code) != 2 {
+		errs = append(errs, errors.New("Code length must be 2"))
+	}
+	for i := range r.Parts {
+		if err := r.Parts[i].Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("Parts[%d]: %w", i, err))
+		}
+	}
+	if r.Owner != nil {
+		if err := r.Owner.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("Owner: %w", err))
+		}
+	}
+	if err := r.Limits.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("Limits: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate answers whether p satisfies the constraints of its fields.
+// Returns an error that joins every violation, or nil if there are none.
+func (p Part) Validate() error {
+	var errs []error
+	if p.Name == "" {
+		errs = append(errs, errors.New("Name must not be zero"))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate answers whether e satisfies the constraints of its fields.
+// Returns an error that joins every violation, or nil if there are none.
+func (e Endpoint) Validate() error {
+	var errs []error
+	if e.Scheme != "http" && e.Scheme != "https" {
+		errs = append(errs, errors.New("Scheme must be one of http, https"))
+	}
+	if !endpointHostRegexp.MatchString(string(e.Host)) {
+		errs = append(errs, errors.New("Host must match ^[a-z.]+$"))
+	}
+	if e.Port < 1 {
+		errs = append(errs, errors.New("Port must be at least 1"))
+	}
+	if e.Port > 65535 {
+		errs = append(errs, errors.New("Port must be at most 65535"))
+	}
+	if e.Timeout > time.Minute {
+		errs = append(errs, errors.New("Timeout must be at most time.Minute"))
+	}
+	return errors.Join(errs...)
+}
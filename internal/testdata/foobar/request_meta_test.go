@@ -0,0 +1,89 @@
+package foobar
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       Request
+		wantErr string
+	}{
+		{
+			name: "valid",
+			r: Request{
+				ID:      "abc-1",
+				Method:  "GET",
+				Retries: 3,
+				Tags:    []string{"a"},
+				Code:    "ok",
+				Parts:   []Part{{Name: "p"}},
+				Owner:   &Part{Name: "o"},
+			},
+		},
+		{
+			name:    "required",
+			r:       Request{Method: "POST", Code: "ok"},
+			wantErr: "ID is required\nID must match ^[a-z0-9-]+$",
+		},
+		{
+			name: "all",
+			r: Request{
+				ID:      "ABC",
+				Method:  "PUT",
+				Retries: 6,
+				Tags:    []string{"a", "b", "c", "d"},
+				Code:    "abc",
+				Parts:   []Part{{Name: "p"}, {}},
+				Owner:   &Part{},
+				Limits:  Limits{Max: -1},
+			},
+			wantErr: "ID must match ^[a-z0-9-]+$\n" +
+				"Method must be one of GET, POST\n" +
+				"Retries must be at most 5\n" +
+				"Tags length must be at most 3\n" +
+				"Code length must be 2\n" +
+				"Parts[1]: Name must not be zero\n" +
+				"Owner: Name must not be zero\n" +
+				"Limits: max must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.r.Validate()
+			var got string
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.wantErr {
+				t.Errorf("Validate() = %v, want %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequest_Validate_errorsIs(t *testing.T) {
+	r := Request{ID: "abc-1", Method: "GET", Code: "ok", Limits: Limits{Max: -1}}
+	if err := r.Validate(); !errors.Is(err, errNegativeMax) {
+		t.Errorf("Validate() = %v, want %v", err, errNegativeMax)
+	}
+}
+
+func TestEndpoint_Validate(t *testing.T) {
+	e := Endpoint{Scheme: "https", Host: "example.com", Port: 443, Timeout: time.Second}
+	if err := e.Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+
+	e = Endpoint{Scheme: "ftp", Host: "Example.com", Port: 0, Timeout: time.Hour}
+	want := "Scheme must be one of http, https\n" +
+		"Host must match ^[a-z.]+$\n" +
+		"Port must be at least 1\n" +
+		"Timeout must be at most time.Minute"
+	if err := e.Validate(); err == nil || err.Error() != want {
+		t.Errorf("Validate() = %v, want %v", err, want)
+	}
+}
@@ -0,0 +1,8 @@
+package foobar
+
+// Samples holds measurements, some of which are discarded as outliers.
+type Samples struct {
+	raw       []float64       `meta:"filter"`
+	clean     []float64       `meta:"filter,inplace"`
+	histogram map[Celsius]int `meta:"lookup;put;keys;values"`
+}
@@ -0,0 +1,83 @@
+package foobar
+
+import (
+	"reflect"
+	"testing"
+)
+
+func isPositive(v float64) bool { return v > 0 }
+
+func TestSamples_FilterClean(t *testing.T) {
+	s := Samples{clean: []float64{1, -1, 2, -2, 3}}
+	backing := s.clean
+	got := s.FilterClean(isPositive)
+	if want := []float64{1, 2, 3}; !reflect.DeepEqual(got, want) || !reflect.DeepEqual(s.clean, want) {
+		t.Errorf("FilterClean() = %v (clean = %v), want %v", got, s.clean, want)
+	}
+	if &got[0] != &backing[0] {
+		t.Errorf("FilterClean() did not reuse the backing array")
+	}
+	if want := []float64{1, 2, 3, 0, 0}; !reflect.DeepEqual(backing, want) {
+		t.Errorf("FilterClean() left backing array %v, want %v", backing, want)
+	}
+
+	if got := s.FilterCleanN(isPositive, 2); !reflect.DeepEqual(got, []float64{1, 2}) {
+		t.Errorf("FilterCleanN() = %v, want [1 2]", got)
+	}
+}
+
+func TestSamples_RetainClean(t *testing.T) {
+	s := Samples{clean: []float64{1, -1, 2, -2, 3}}
+	s.RetainClean(isPositive)
+	if want := []float64{1, 2, 3}; !reflect.DeepEqual(s.clean, want) {
+		t.Errorf("RetainClean() = %v, want %v", s.clean, want)
+	}
+
+	s = Samples{clean: []float64{1, -1, 2, -2, 3}}
+	s.RejectClean(isPositive)
+	if want := []float64{-1, -2}; !reflect.DeepEqual(s.clean, want) {
+		t.Errorf("RejectClean() = %v, want %v", s.clean, want)
+	}
+}
+
+func newSampleData() []float64 {
+	data := make([]float64, 1024)
+	for i := range data {
+		data[i] = float64(i%7 - 3)
+	}
+	return data
+}
+
+func BenchmarkSamples_FilterRawN(b *testing.B) {
+	data := newSampleData()
+	s := Samples{raw: data}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.FilterRawN(isPositive, -1)
+	}
+}
+
+func BenchmarkSamples_FilterCleanN(b *testing.B) {
+	data := newSampleData()
+	s := Samples{clean: make([]float64, len(data))}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.clean = s.clean[:len(data)]
+		copy(s.clean, data)
+		s.FilterCleanN(isPositive, -1)
+	}
+}
+
+func TestSamples_Histogram(t *testing.T) {
+	var s Samples
+	for _, c := range []Celsius{21.5, -4, 21.5, 3} {
+		n, _ := s.GetHistogramValue(c)
+		s.PutHistogram(c, n+1)
+	}
+	if got, want := s.HistogramKeys(), []Celsius{-4, 3, 21.5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("HistogramKeys() = %v, want %v", got, want)
+	}
+	if got, want := s.HistogramValues(), []int{1, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("HistogramValues() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,26 @@
+package foobar
+
+import "time"
+
+type Server struct {
+	host    string   `meta:"builder,required"`
+	port    int      `meta:"builder,default=8080"`
+	name    string   `meta:"builder,default=metatag"`
+	aliases []string `meta:"builder"`
+}
+
+type Client struct {
+	addr string `meta:"ptr;builder,required"`
+}
+
+type Service struct {
+	name    string        `meta:"new"`
+	timeout time.Duration `meta:"option,default=30*time.Second"`
+	retries int           `meta:"option"`
+	hooks   []string      `meta:"option"`
+}
+
+// Worker shares an option field with Service, so both With functions are qualified by the type.
+type Worker struct {
+	retries int `meta:"option"`
+}
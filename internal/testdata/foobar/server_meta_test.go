@@ -0,0 +1,56 @@
+package foobar
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestServerBuilder_Build(t *testing.T) {
+	got, err := NewServerBuilder().
+		Host("localhost").
+		Aliases("a", "b").
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	want := Server{host: "localhost", port: 8080, name: "metatag", aliases: []string{"a", "b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Build() = %v, want %v", got, want)
+	}
+}
+
+func TestServerBuilder_Build_missing(t *testing.T) {
+	_, err := NewServerBuilder().Port(80).Build()
+	if err == nil || err.Error() != "Server: missing required fields: host" {
+		t.Errorf("Build() error = %v, want missing host", err)
+	}
+}
+
+func TestClientBuilder_Build(t *testing.T) {
+	got, err := NewClientBuilder().Addr("localhost:80").Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if got.addr != "localhost:80" {
+		t.Errorf("Build().addr = %v, want %v", got.addr, "localhost:80")
+	}
+}
+
+func TestNewService(t *testing.T) {
+	got := NewService("svc", WithServiceRetries(3), WithHooks("a", "b"))
+	want := &Service{name: "svc", timeout: 30 * time.Second, retries: 3, hooks: []string{"a", "b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewService() = %v, want %v", got, want)
+	}
+
+	if got := NewService("svc", WithTimeout(time.Second)); got.timeout != time.Second {
+		t.Errorf("NewService().timeout = %v, want %v", got.timeout, time.Second)
+	}
+}
+
+func TestNewWorker(t *testing.T) {
+	if got := NewWorker(WithWorkerRetries(2)); got.retries != 2 {
+		t.Errorf("NewWorker().retries = %v, want %v", got.retries, 2)
+	}
+}
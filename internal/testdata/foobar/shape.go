@@ -0,0 +1,43 @@
+package foobar
+
+import "time"
+
+type Shape struct {
+	name   string            `meta:"equal,typed;hash"`
+	data   []byte            `meta:"equal;hash"`
+	points []Point           `meta:"equal;hash"`
+	attrs  map[string][]int  `meta:"equal;hash"`
+	tags   map[string]string `meta:"equal;hash"`
+	scale  float64           `meta:"equal,epsilon=1e-9"`
+	origin *Point            `meta:"equal;hash"`
+	at     time.Time         `meta:"equal;hash"`
+	path   Path              `meta:"equal"`
+	stamp  Stamp             `meta:"equal;hash"`
+}
+
+// Stamp has Equal and Hash methods with pointer receivers.
+type Stamp struct {
+	at time.Time `meta:"ptr;equal,typed;hash"`
+}
+
+type Point struct {
+	X, Y int `meta:"equal,typed;hash"`
+}
+
+// Path is not comparable, so Shape compares it using its Equal method.
+type Path struct {
+	steps []Point
+}
+
+// Equal answers whether p2 visits the same points as p.
+func (p *Path) Equal(p2 *Path) bool {
+	if len(p.steps) != len(p2.steps) {
+		return false
+	}
+	for i := range p.steps {
+		if p.steps[i] != p2.steps[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,172 @@
+// Code generated by metatag (devel) from shape.go; DO NOT EDIT.
+// (or edit away - I'm a comment, not a cop)
+// Inputs: sha256:04d93ee80ae5212ac8edf72229ebb1589741ecf89c69eb8abba537f7a17b62f9
+
+package foobar
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/fnv"
+	"io"
+	"math"
+)
+
+// Equal answers whether s2 is equivalent to s.
+func (s Shape) Equal(s2 Shape) bool {
+	if s.name != s2.name {
+		return false
+	}
+	if !bytes.Equal(s.data, s2.data) {
+		return false
+	}
+	if len(s.points) != len(s2.points) {
+		return false
+	}
+	for i := range s.points {
+		if !s.points[i].Equal(s2.points[i]) {
+			return false
+		}
+	}
+	if len(s.attrs) != len(s2.attrs) {
+		return false
+	}
+	for k, v := range s.attrs {
+		w, ok := s2.attrs[k]
+		if !ok {
+			return false
+		}
+		if len(v) != len(w) {
+			return false
+		}
+		for i1 := range v {
+			if v[i1] != w[i1] {
+				return false
+			}
+		}
+	}
+	if len(s.tags) != len(s2.tags) {
+		return false
+	}
+	for k, v := range s.tags {
+		w, ok := s2.tags[k]
+		if !ok {
+			return false
+		}
+		if v != w {
+			return false
+		}
+	}
+	if math.Abs(float64(s.scale-s2.scale)) > 1e-9 {
+		return false
+	}
+	if (s.origin == nil) != (s2.origin == nil) {
+		return false
+	}
+	if s.origin != nil {
+		if !(*s.origin).Equal((*s2.origin)) {
+			return false
+		}
+	}
+	if !s.at.Equal(s2.at) {
+		return false
+	}
+	if !s.path.Equal(&s2.path) {
+		return false
+	}
+	if !s.stamp.Equal(&s2.stamp) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of the fields of s that is consistent with Equal,
+// i.e. equivalent values have equal hashes.
+func (s Shape) Hash() uint64 {
+	h := fnv.New64a()
+	binary.Write(h, binary.LittleEndian, uint64(len(s.name)))
+	io.WriteString(h, s.name)
+	binary.Write(h, binary.LittleEndian, uint64(len(s.data)))
+	h.Write(s.data)
+	binary.Write(h, binary.LittleEndian, uint64(len(s.points)))
+	for i := range s.points {
+		binary.Write(h, binary.LittleEndian, s.points[i].Hash())
+	}
+	{
+		var sum uint64
+		for k, v := range s.attrs {
+			h1 := fnv.New64a()
+			binary.Write(h1, binary.LittleEndian, uint64(len(k)))
+			io.WriteString(h1, k)
+			binary.Write(h1, binary.LittleEndian, uint64(len(v)))
+			for i1 := range v {
+				binary.Write(h1, binary.LittleEndian, int64(v[i1]))
+			}
+			sum += h1.Sum64()
+		}
+		binary.Write(h, binary.LittleEndian, uint64(len(s.attrs)))
+		binary.Write(h, binary.LittleEndian, sum)
+	}
+	{
+		var sum uint64
+		for k, v := range s.tags {
+			h1 := fnv.New64a()
+			binary.Write(h1, binary.LittleEndian, uint64(len(k)))
+			io.WriteString(h1, k)
+			binary.Write(h1, binary.LittleEndian, uint64(len(v)))
+			io.WriteString(h1, v)
+			sum += h1.Sum64()
+		}
+		binary.Write(h, binary.LittleEndian, uint64(len(s.tags)))
+		binary.Write(h, binary.LittleEndian, sum)
+	}
+	binary.Write(h, binary.LittleEndian, s.origin != nil)
+	if s.origin != nil {
+		binary.Write(h, binary.LittleEndian, (*s.origin).Hash())
+	}
+	binary.Write(h, binary.LittleEndian, s.at.UnixNano())
+	binary.Write(h, binary.LittleEndian, s.stamp.Hash())
+	return h.Sum64()
+}
+
+// Equal answers whether s2 is equivalent to s.
+func (s *Stamp) Equal(s2 *Stamp) bool {
+	if s == nil || s2 == nil {
+		return s == s2
+	}
+	if !s.at.Equal(s2.at) {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of the fields of s that is consistent with Equal,
+// i.e. equivalent values have equal hashes.
+func (s *Stamp) Hash() uint64 {
+	if s == nil {
+		return 0
+	}
+	h := fnv.New64a()
+	binary.Write(h, binary.LittleEndian, s.at.UnixNano())
+	return h.Sum64()
+}
+
+// Equal answers whether p2 is equivalent to p.
+func (p Point) Equal(p2 Point) bool {
+	if p.X != p2.X {
+		return false
+	}
+	if p.Y != p2.Y {
+		return false
+	}
+	return true
+}
+
+// Hash returns a hash of the fields of p that is consistent with Equal,
+// i.e. equivalent values have equal hashes.
+func (p Point) Hash() uint64 {
+	h := fnv.New64a()
+	binary.Write(h, binary.LittleEndian, int64(p.X))
+	binary.Write(h, binary.LittleEndian, int64(p.Y))
+	return h.Sum64()
+}
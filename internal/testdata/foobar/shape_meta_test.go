@@ -0,0 +1,111 @@
+package foobar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestShape_Equal(t *testing.T) {
+	newShape := func() Shape {
+		return Shape{
+			name:   "square",
+			data:   []byte("abc"),
+			points: []Point{{0, 0}, {1, 1}},
+			attrs:  map[string][]int{"a": {1, 2}},
+			tags:   map[string]string{"x": "1", "y": "2"},
+			scale:  0.3,
+			origin: &Point{1, 2},
+			at:     time.Unix(1, 0),
+			path:   Path{steps: []Point{{0, 0}, {0, 1}}},
+			stamp:  Stamp{at: time.Unix(3, 0)},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*Shape)
+		want   bool
+	}{
+		{name: "same", modify: func(s *Shape) {}, want: true},
+		{name: "epsilon", modify: func(s *Shape) { s.scale = 0.1 + 0.2 }, want: true},
+		{name: "location", modify: func(s *Shape) { s.at = s.at.UTC() }, want: true},
+		{name: "name", modify: func(s *Shape) { s.name = "circle" }, want: false},
+		{name: "data", modify: func(s *Shape) { s.data = []byte("abd") }, want: false},
+		{name: "points", modify: func(s *Shape) { s.points[1].Y = 2 }, want: false},
+		{name: "attrs", modify: func(s *Shape) { s.attrs["a"] = []int{1} }, want: false},
+		{name: "tags", modify: func(s *Shape) { s.tags["y"] = "3" }, want: false},
+		{name: "tags swapped", modify: func(s *Shape) { s.tags = map[string]string{"x": "2", "y": "1"} }, want: false},
+		{name: "scale", modify: func(s *Shape) { s.scale = 0.4 }, want: false},
+		{name: "nil origin", modify: func(s *Shape) { s.origin = nil }, want: false},
+		{name: "origin", modify: func(s *Shape) { s.origin = &Point{2, 1} }, want: false},
+		{name: "at", modify: func(s *Shape) { s.at = time.Unix(2, 0) }, want: false},
+		{name: "path", modify: func(s *Shape) { s.path.steps[1].X = 1 }, want: false},
+		{name: "stamp location", modify: func(s *Shape) { s.stamp.at = s.stamp.at.UTC() }, want: true},
+		{name: "stamp", modify: func(s *Shape) { s.stamp.at = time.Unix(4, 0) }, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, s2 := newShape(), newShape()
+			tt.modify(&s2)
+			if got := s.Equal(s2); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShape_Hash(t *testing.T) {
+	newShape := func() Shape {
+		return Shape{
+			name:   "square",
+			data:   []byte("abc"),
+			points: []Point{{0, 0}, {1, 1}},
+			attrs:  map[string][]int{"a": {1, 2}, "b": {3}, "c": nil},
+			tags:   map[string]string{"x": "1", "y": "2"},
+			origin: &Point{1, 2},
+			at:     time.Unix(1, 0),
+			stamp:  Stamp{at: time.Unix(3, 0)},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*Shape)
+		want   bool
+	}{
+		{name: "same", modify: func(s *Shape) {}, want: true},
+		{name: "location", modify: func(s *Shape) { s.at = s.at.UTC() }, want: true},
+		{name: "stamp location", modify: func(s *Shape) { s.stamp.at = s.stamp.at.UTC() }, want: true},
+		{name: "stamp", modify: func(s *Shape) { s.stamp.at = time.Unix(4, 0) }, want: false},
+		{name: "nil data", modify: func(s *Shape) { s.data = nil }, want: false},
+		{name: "name", modify: func(s *Shape) { s.name = "circle" }, want: false},
+		{name: "name boundary", modify: func(s *Shape) { s.name, s.data = "squarea", []byte("bc") }, want: false},
+		{name: "points", modify: func(s *Shape) { s.points[1].Y = 2 }, want: false},
+		{name: "attrs", modify: func(s *Shape) { s.attrs["a"] = []int{1} }, want: false},
+		{name: "tags", modify: func(s *Shape) { s.tags["y"] = "3" }, want: false},
+		{name: "tags swapped", modify: func(s *Shape) { s.tags = map[string]string{"x": "2", "y": "1"} }, want: false},
+		{name: "nil origin", modify: func(s *Shape) { s.origin = nil }, want: false},
+		{name: "origin", modify: func(s *Shape) { s.origin = &Point{2, 1} }, want: false},
+		{name: "at", modify: func(s *Shape) { s.at = time.Unix(2, 0) }, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, s2 := newShape(), newShape()
+			tt.modify(&s2)
+			if got := s.Hash() == s2.Hash(); got != tt.want {
+				t.Errorf("Hash() == Hash() = %v, want %v", got, tt.want)
+			}
+			if s.Equal(s2) && s.Hash() != s2.Hash() {
+				t.Errorf("Equal() but Hash() differs")
+			}
+		})
+	}
+
+	// map iteration order must not matter
+	want := newShape().Hash()
+	for i := 0; i < 10; i++ {
+		if got := newShape().Hash(); got != want {
+			t.Errorf("Hash() = %v, want %v", got, want)
+		}
+	}
+}
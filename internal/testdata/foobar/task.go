@@ -0,0 +1,11 @@
+package foobar
+
+type Task struct {
+	name     string
+	priority int
+}
+
+// Tasks is a priority queue that pops the task with the lowest priority value first.
+type Tasks struct {
+	tasks []Task `meta:"new;sort,by=priority;heap"`
+}
@@ -0,0 +1,86 @@
+// Code generated by metatag (devel) from task.go; DO NOT EDIT.
+// (or edit away - I'm a comment, not a cop)
+// Inputs: sha256:af83370e2d93fac821e41e0f745bcf5f6ff1d2eb29c033d3c91639ba12ee06b4
+
+package foobar
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// NewTasks creates a new Tasks with the given initial values.
+func NewTasks(tasks []Task) Tasks {
+	return Tasks{
+		tasks: tasks,
+	}
+}
+
+// Len is the number of elements in the collection.
+func (t Tasks) Len() int {
+	return len(t.tasks)
+}
+
+// Swap swaps the elements with indexes i and j.
+func (t Tasks) Swap(i, j int) {
+	t.tasks[i], t.tasks[j] = t.tasks[j], t.tasks[i]
+}
+
+// Less reports whether the element with
+// index i should sort before the element with index j.
+func (t Tasks) Less(i, j int) bool {
+	return t.tasks[i].priority < t.tasks[j].priority
+}
+
+// Sort is a convenience method.
+func (t Tasks) Sort() Tasks {
+	sort.Sort(t)
+	return t
+}
+
+// IsSorted reports whether the collection is sorted.
+func (t Tasks) IsSorted() bool {
+	return sort.IsSorted(t)
+}
+
+// Push implements heap.Interface. Use PushItem instead.
+func (t *Tasks) Push(x interface{}) {
+	t.tasks = append(t.tasks, x.(Task))
+}
+
+// Pop implements heap.Interface. Use PopItem instead.
+func (t *Tasks) Pop() interface{} {
+	n := len(t.tasks) - 1
+	v := t.tasks[n]
+	var zero Task
+	t.tasks[n] = zero
+	t.tasks = t.tasks[:n]
+	return v
+}
+
+// Init establishes the heap invariant. A sorted collection is a valid heap as well.
+func (t *Tasks) Init() {
+	heap.Init(t)
+}
+
+// PushItem pushes v onto the heap.
+func (t *Tasks) PushItem(v Task) {
+	heap.Push(t, v)
+}
+
+// PopItem removes and returns the minimum element (according to Less) from the heap.
+// PopItem panics if the heap is empty.
+func (t *Tasks) PopItem() Task {
+	return heap.Pop(t).(Task)
+}
+
+// Peek returns the minimum element (according to Less) without removing it from the heap.
+// Peek panics if the heap is empty.
+func (t Tasks) Peek() Task {
+	return t.tasks[0]
+}
+
+// Fix re-establishes the heap ordering after the element at index i has changed its value.
+func (t *Tasks) Fix(i int) {
+	heap.Fix(t, i)
+}
@@ -0,0 +1,34 @@
+package foobar
+
+import (
+	"container/heap"
+	"testing"
+)
+
+var _ heap.Interface = &Tasks{}
+
+func TestTasks_Heap(t *testing.T) {
+	tasks := NewTasks([]Task{{"b", 2}, {"d", 4}, {"a", 1}})
+	tasks.Init()
+	tasks.PushItem(Task{"c", 3})
+	tasks.PushItem(Task{"e", 0})
+	if got := tasks.Peek(); got.name != "e" {
+		t.Errorf("Peek() = %v, want e", got)
+	}
+
+	// demote e
+	for i := range tasks.tasks {
+		if tasks.tasks[i].name == "e" {
+			tasks.tasks[i].priority = 5
+			tasks.Fix(i)
+		}
+	}
+
+	var got string
+	for tasks.Len() > 0 {
+		got += tasks.PopItem().name
+	}
+	if want := "abcde"; got != want {
+		t.Errorf("PopItem() order = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,5 @@
+module github.com/phelmkamp/metatag/internal/testdata
+
+go 1.23
+
+require github.com/satori/go.uuid v1.2.0
@@ -7,3 +7,8 @@ import (
 type Cat struct {
 	Uuid      uuid.UUID `meta:"getter"`
 }
+
+type Kitten struct {
+	Name string
+	Age  int
+}
@@ -1,9 +1,15 @@
 package dog
 
 import (
+	"github.com/phelmkamp/metatag/internal/testdata/imports/cat"
 	"github.com/satori/go.uuid"
 )
 
 type Dog struct {
 	Uuid      uuid.UUID `meta:"equal"`
 }
+
+// Pack keeps the kittens it looks after ordered from youngest to oldest.
+type Pack struct {
+	kittens []cat.Kitten `meta:"index,by=Name;sort,by=Age"`
+}
@@ -1,10 +1,12 @@
-// GENERATED BY metatag, DO NOT EDIT
+// Code generated by metatag (devel) from dog.go; DO NOT EDIT.
 // (or edit away - I'm a comment, not a cop)
+// Inputs: sha256:8d4dbb57034fd744cd36b833d0cf3df40122b0315cdc2c2b0a2cadea7fe47969
 
 package dog
 
 import (
-	"github.com/satori/go.uuid"
+	"github.com/phelmkamp/metatag/internal/testdata/imports/cat"
+	"sort"
 )
 
 // Equal answers whether v is equivalent to d.
@@ -19,3 +21,54 @@ func (d Dog) Equal(v interface{}) bool {
 	}
 	return true
 }
+
+// KittensByName returns the elements of kittens by their Name.
+// If several elements have the same Name, the first one is used.
+func (p Pack) KittensByName() map[string]cat.Kitten {
+	result := make(map[string]cat.Kitten, len(p.kittens))
+	for i := range p.kittens {
+		if _, ok := result[p.kittens[i].Name]; !ok {
+			result[p.kittens[i].Name] = p.kittens[i]
+		}
+	}
+	return result
+}
+
+// FindKittensByName returns the first element of kittens with the given Name
+// and answers whether there is one.
+func (p Pack) FindKittensByName(name string) (cat.Kitten, bool) {
+	for i := range p.kittens {
+		if p.kittens[i].Name == name {
+			return p.kittens[i], true
+		}
+	}
+	var zero cat.Kitten
+	return zero, false
+}
+
+// Len is the number of elements in the collection.
+func (p Pack) Len() int {
+	return len(p.kittens)
+}
+
+// Swap swaps the elements with indexes i and j.
+func (p Pack) Swap(i, j int) {
+	p.kittens[i], p.kittens[j] = p.kittens[j], p.kittens[i]
+}
+
+// Less reports whether the element with
+// index i should sort before the element with index j.
+func (p Pack) Less(i, j int) bool {
+	return p.kittens[i].Age < p.kittens[j].Age
+}
+
+// Sort is a convenience method.
+func (p Pack) Sort() Pack {
+	sort.Sort(p)
+	return p
+}
+
+// IsSorted reports whether the collection is sorted.
+func (p Pack) IsSorted() bool {
+	return sort.IsSorted(p)
+}
@@ -1,6 +1,10 @@
 package dog
 
 import (
+	"reflect"
+	"testing"
+
+	"github.com/phelmkamp/metatag/internal/testdata/imports/cat"
 	"github.com/satori/go.uuid"
 )
 
@@ -12,3 +16,13 @@ func Example() {
 	dog.Equal(dog)
 }
 
+func TestPack_Sort(t *testing.T) {
+	p := Pack{kittens: []cat.Kitten{{Name: "Tom", Age: 3}, {Name: "Kit", Age: 1}, {Name: "Tom", Age: 2}}}.Sort()
+	want := []cat.Kitten{{Name: "Kit", Age: 1}, {Name: "Tom", Age: 2}, {Name: "Tom", Age: 3}}
+	if !reflect.DeepEqual(p.kittens, want) {
+		t.Errorf("Sort() = %v, want %v", p.kittens, want)
+	}
+	if got, ok := p.FindKittensByName("Tom"); !ok || got.Age != 2 {
+		t.Errorf("FindKittensByName() = %v, %v, want {Tom 2}, true", got, ok)
+	}
+}
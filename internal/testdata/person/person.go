@@ -3,10 +3,20 @@ package person
 import "time"
 
 type Person struct {
-	Name      string `meta:"stringer"`
-	Birthdate time.Time
+	Name      string    `meta:"stringer;compare,2"`
+	Birthdate time.Time `meta:"compare,1,desc"`
 }
 
 type Persons struct {
-	result []Person `meta:"wrapper;new;filter;mapper,int;sort,func;getter"`
+	result []Person `meta:"wrapper;new;filter;mapper,int;sort,func;getter;any;all;count;find;index;groupby,int;index,by=Name;distinct;partition;chunk"`
+}
+
+// Roster keeps persons ordered from youngest to oldest.
+type Roster struct {
+	persons []Person `meta:"wrapper;new;sort,compare;getter;iter,filter,map=string"`
+}
+
+// Cohort keeps persons ordered from oldest to youngest, in order of insertion otherwise.
+type Cohort struct {
+	persons []Person `meta:"wrapper;new;sort,by=Birthdate,stable;search;getter"`
 }
@@ -1,10 +1,12 @@
-// GENERATED BY metatag, DO NOT EDIT
+// Code generated by metatag (devel) from person.go; DO NOT EDIT.
 // (or edit away - I'm a comment, not a cop)
+// Inputs: sha256:4b70ddb86c6ad6534364e64592f3f0741a070a00cfb1caca1fb831d37e60137c
 
 package person
 
 import (
 	"fmt"
+	"iter"
 	"sort"
 )
 
@@ -18,6 +20,21 @@ func (p Person) String() string {
 	return fmt.Sprintf("%v", p.Name)
 }
 
+// Compare returns -1 if p is less than p2,
+// 0 if they are equal and +1 if p is greater than p2.
+func (p Person) Compare(p2 Person) int {
+	if n := p.Birthdate.Compare(p2.Birthdate); n != 0 {
+		return -n
+	}
+	switch {
+	case p.Name < p2.Name:
+		return -1
+	case p.Name > p2.Name:
+		return +1
+	}
+	return 0
+}
+
 // NewPersons creates a new Persons with the given initial values.
 func NewPersons(result []Person) Persons {
 	return Persons{
@@ -83,7 +100,303 @@ func (p Persons) Sort(less func(vi, vj Person) bool) Persons {
     return p
 }
 
+// IsSorted reports whether the collection is sorted according to the given less function.
+func (p Persons) IsSorted(less func(vi, vj Person) bool) bool {
+    return sort.IsSorted(personsLesser{
+        Persons: p,
+        less: less,
+    })
+}
+
 // Result returns the value of result.
 func (p Persons) Result() []Person {
 	return p.result
 }
+
+// Any answers whether the given function accepts any element of result.
+func (p Persons) Any(fn func(Person) bool) bool {
+	for i := range p.result {
+		if fn(p.result[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// All answers whether the given function accepts all elements of result.
+// Always returns true if result is empty.
+func (p Persons) All(fn func(Person) bool) bool {
+	for i := range p.result {
+		if !fn(p.result[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Count returns the number of elements of result that are accepted by the given function.
+func (p Persons) Count(fn func(Person) bool) int {
+	var n int
+	for i := range p.result {
+		if fn(p.result[i]) {
+			n++
+		}
+	}
+	return n
+}
+
+// Find returns the first element of result that is accepted by the given function
+// and answers whether there is one.
+func (p Persons) Find(fn func(Person) bool) (Person, bool) {
+	for i := range p.result {
+		if fn(p.result[i]) {
+			return p.result[i], true
+		}
+	}
+	var zero Person
+	return zero, false
+}
+
+// Index returns the index of the first element of result that is accepted by the given function,
+// or -1 if there is none.
+func (p Persons) Index(fn func(Person) bool) int {
+	for i := range p.result {
+		if fn(p.result[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// GroupBy groups the elements of result by the keys returned by the given function.
+// The elements of each group are in their original order.
+func (p Persons) GroupBy(fn func(Person) int) map[int][]Person {
+	result := make(map[int][]Person)
+	for i := range p.result {
+		k := fn(p.result[i])
+		result[k] = append(result[k], p.result[i])
+	}
+	return result
+}
+
+// ByName returns the elements of result by their Name.
+// If several elements have the same Name, the first one is used.
+func (p Persons) ByName() map[string]Person {
+	result := make(map[string]Person, len(p.result))
+	for i := range p.result {
+		if _, ok := result[p.result[i].Name]; !ok {
+			result[p.result[i].Name] = p.result[i]
+		}
+	}
+	return result
+}
+
+// FindByName returns the first element of result with the given Name
+// and answers whether there is one.
+func (p Persons) FindByName(name string) (Person, bool) {
+	for i := range p.result {
+		if p.result[i].Name == name {
+			return p.result[i], true
+		}
+	}
+	var zero Person
+	return zero, false
+}
+
+// Distinct returns a copy of result without duplicate elements.
+// The first occurrence of each element is kept, in order.
+func (p Persons) Distinct() Persons {
+	seen := make(map[Person]struct{}, len(p.result))
+	result := make([]Person, 0, len(p.result))
+	for i := range p.result {
+		id := p.result[i]
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			result = append(result, p.result[i])
+		}
+	}
+	p.result = result
+	return p
+}
+
+// Partition splits result into the elements that are accepted by the given function and the rest.
+// Both retain the original order.
+func (p Persons) Partition(fn func(Person) bool) (matched, rest Persons) {
+	var ms, rs []Person
+	for i := range p.result {
+		if fn(p.result[i]) {
+			ms = append(ms, p.result[i])
+		} else {
+			rs = append(rs, p.result[i])
+		}
+	}
+	mc, rc := p, p
+	mc.result, rc.result = ms, rs
+	return mc, rc
+}
+
+// Chunk splits result into consecutive chunks of the given size; the last chunk may be smaller.
+// The chunks share the backing array of result. A size < 1 results in a single chunk.
+func (p Persons) Chunk(size int) []Persons {
+	if size < 1 {
+		size = len(p.result)
+	}
+	var result []Persons
+	for i := 0; i < len(p.result); i += size {
+		end := i + size
+		if end > len(p.result) {
+			end = len(p.result)
+		}
+		chunk := p
+		chunk.result = p.result[i:end:end]
+		result = append(result, chunk)
+	}
+	return result
+}
+
+// NewRoster creates a new Roster with the given initial values.
+func NewRoster(persons []Person) Roster {
+	return Roster{
+		persons: persons,
+	}
+}
+
+// Len is the number of elements in the collection.
+func (r Roster) Len() int {
+	return len(r.persons)
+}
+
+// Swap swaps the elements with indexes i and j.
+func (r Roster) Swap(i, j int) {
+	r.persons[i], r.persons[j] = r.persons[j], r.persons[i]
+}
+
+// Less reports whether the element with
+// index i should sort before the element with index j.
+func (r Roster) Less(i, j int) bool {
+	return r.persons[i].Compare(r.persons[j]) < 0
+}
+
+// Sort is a convenience method.
+func (r Roster) Sort() Roster {
+	sort.Sort(r)
+	return r
+}
+
+// IsSorted reports whether the collection is sorted.
+func (r Roster) IsSorted() bool {
+	return sort.IsSorted(r)
+}
+
+// Persons returns the value of persons.
+func (r Roster) Persons() []Person {
+	return r.persons
+}
+
+// All returns an iterator over the elements of persons.
+func (r Roster) All() iter.Seq[Person] {
+	return func(yield func(Person) bool) {
+		for i := range r.persons {
+			if !yield(r.persons[i]) {
+				return
+			}
+		}
+	}
+}
+
+// FilterSeq returns an iterator over the elements of persons that are accepted by the given function.
+// Unlike Filter, it does not allocate a new slice.
+func (r Roster) FilterSeq(fn func(Person) bool) iter.Seq[Person] {
+	return func(yield func(Person) bool) {
+		for i := range r.persons {
+			if fn(r.persons[i]) && !yield(r.persons[i]) {
+				return
+			}
+		}
+	}
+}
+
+// MapToStringSeq returns an iterator over the results of calling the given function for each element of persons.
+// Unlike the mapper methods, it does not allocate a new slice.
+func (r Roster) MapToStringSeq(fn func(Person) string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for i := range r.persons {
+			if !yield(fn(r.persons[i])) {
+				return
+			}
+		}
+	}
+}
+
+// NewCohort creates a new Cohort with the given initial values.
+func NewCohort(persons []Person) Cohort {
+	return Cohort{
+		persons: persons,
+	}
+}
+
+// Len is the number of elements in the collection.
+func (c Cohort) Len() int {
+	return len(c.persons)
+}
+
+// Swap swaps the elements with indexes i and j.
+func (c Cohort) Swap(i, j int) {
+	c.persons[i], c.persons[j] = c.persons[j], c.persons[i]
+}
+
+// Less reports whether the element with
+// index i should sort before the element with index j.
+func (c Cohort) Less(i, j int) bool {
+	return c.persons[i].Birthdate.Compare(c.persons[j].Birthdate) < 0
+}
+
+// Sort is a convenience method.
+func (c Cohort) Sort() Cohort {
+	sort.Stable(c)
+	return c
+}
+
+// IsSorted reports whether the collection is sorted.
+func (c Cohort) IsSorted() bool {
+	return sort.IsSorted(c)
+}
+
+// Search returns the index of v in the sorted collection and whether it is present.
+// If v is not present, the index is where it would be inserted.
+func (c Cohort) Search(v Person) (int, bool) {
+	i := sort.Search(len(c.persons), func(i int) bool {
+		return !(c.persons[i].Birthdate.Compare(v.Birthdate) < 0)
+	})
+	return i, i < len(c.persons) && !(v.Birthdate.Compare(c.persons[i].Birthdate) < 0)
+}
+
+// InsertSorted inserts v into the sorted collection, after any equal elements.
+func (c *Cohort) InsertSorted(v Person) {
+	i := sort.Search(len(c.persons), func(i int) bool {
+		return v.Birthdate.Compare(c.persons[i].Birthdate) < 0
+	})
+	c.persons = append(c.persons, v)
+	copy(c.persons[i+1:], c.persons[i:])
+	c.persons[i] = v
+}
+
+// RemoveSorted removes the first element that is equal to v from the sorted collection
+// and answers whether there was one.
+func (c *Cohort) RemoveSorted(v Person) bool {
+	i, ok := c.Search(v)
+	if !ok {
+		return false
+	}
+	copy(c.persons[i:], c.persons[i+1:])
+	// zero the vacated element so that it can be garbage collected
+	var zero Person
+	c.persons[len(c.persons)-1] = zero
+	c.persons = c.persons[:len(c.persons)-1]
+	return true
+}
+
+// Persons returns the value of persons.
+func (c Cohort) Persons() []Person {
+	return c.persons
+}
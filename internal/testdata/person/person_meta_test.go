@@ -27,15 +27,15 @@ func Example() {
 	hasName := func(p Person) bool { return p.Name == name }
 
 	name = "David"
-	if found := NewPersons(ps).FilterN(hasName, 1).Result(); len(found) > 0 {
+	if p, ok := NewPersons(ps).Find(hasName); ok {
 		// contains David
-		fmt.Println(found[0])
+		fmt.Println(p)
 	}
 
 	name = "Bob"
-	if found := NewPersons(ps).FilterN(hasName, 1).Result(); len(found) > 0 {
+	if p, ok := NewPersons(ps).Find(hasName); ok {
 		// contains Bob
-		fmt.Println(found[0])
+		fmt.Println(p)
 	}
 
 	ages := NewPersons(ps).
@@ -90,3 +90,185 @@ func TestPersons_Sort(t *testing.T) {
 		})
 	}
 }
+
+func TestPerson_Compare(t *testing.T) {
+	older := time.Date(1983, time.February, 12, 0, 0, 0, 0, time.UTC)
+	younger := time.Date(1994, time.June, 28, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		p    Person
+		p2   Person
+		want int
+	}{
+		{name: "same", p: Person{"Ann", older}, p2: Person{"Ann", older}, want: 0},
+		{name: "younger first", p: Person{"Bob", younger}, p2: Person{"Ann", older}, want: -1},
+		{name: "older last", p: Person{"Ann", older}, p2: Person{"Bob", younger}, want: +1},
+		{name: "then by name", p: Person{"Ann", older}, p2: Person{"Bob", older}, want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Compare(tt.p2); got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoster_Sort(t *testing.T) {
+	older := time.Date(1983, time.February, 12, 0, 0, 0, 0, time.UTC)
+	younger := time.Date(1994, time.June, 28, 0, 0, 0, 0, time.UTC)
+	r := NewRoster([]Person{
+		{Name: "Charlie", Birthdate: older},
+		{Name: "Ann", Birthdate: older},
+		{Name: "Bob", Birthdate: younger},
+	}).Sort()
+	want := []Person{
+		{Name: "Bob", Birthdate: younger},
+		{Name: "Ann", Birthdate: older},
+		{Name: "Charlie", Birthdate: older},
+	}
+	if !reflect.DeepEqual(r.Persons(), want) {
+		t.Errorf("got = %v, want %v", r.Persons(), want)
+	}
+}
+
+func TestCohort_Sort(t *testing.T) {
+	older := time.Date(1983, time.February, 12, 0, 0, 0, 0, time.UTC)
+	younger := time.Date(1994, time.June, 28, 0, 0, 0, 0, time.UTC)
+	c := NewCohort([]Person{
+		{Name: "Charlie", Birthdate: younger},
+		{Name: "Bob", Birthdate: older},
+		{Name: "Ann", Birthdate: younger},
+	})
+	if c.IsSorted() {
+		t.Errorf("IsSorted() = true, want false")
+	}
+	c.Sort()
+	want := []Person{
+		{Name: "Bob", Birthdate: older},
+		{Name: "Charlie", Birthdate: younger},
+		{Name: "Ann", Birthdate: younger},
+	}
+	if !reflect.DeepEqual(c.Persons(), want) {
+		t.Errorf("got = %v, want %v", c.Persons(), want)
+	}
+}
+
+func TestCohort_InsertSorted(t *testing.T) {
+	older := time.Date(1983, time.February, 12, 0, 0, 0, 0, time.UTC)
+	younger := time.Date(1994, time.June, 28, 0, 0, 0, 0, time.UTC)
+	c := NewCohort([]Person{{Name: "Bob", Birthdate: older}})
+	c.InsertSorted(Person{Name: "Charlie", Birthdate: younger})
+	c.InsertSorted(Person{Name: "Ann", Birthdate: older})
+	want := []Person{
+		{Name: "Bob", Birthdate: older},
+		{Name: "Ann", Birthdate: older},
+		{Name: "Charlie", Birthdate: younger},
+	}
+	if !reflect.DeepEqual(c.Persons(), want) {
+		t.Errorf("got = %v, want %v", c.Persons(), want)
+	}
+	if i, ok := c.Search(Person{Birthdate: younger}); i != 2 || !ok {
+		t.Errorf("Search() = %v, %v, want 2, true", i, ok)
+	}
+}
+
+func TestPersons_Query(t *testing.T) {
+	p := NewPersons([]Person{{Name: "Ann"}, {Name: "Bob"}, {Name: "Bea"}})
+	startsWithB := func(p Person) bool { return p.Name[0] == 'B' }
+	hasName := func(p Person) bool { return p.Name != "" }
+
+	if !p.Any(startsWithB) {
+		t.Errorf("Any() = false, want true")
+	}
+	if p.All(startsWithB) || !p.All(hasName) {
+		t.Errorf("All() = wrong result")
+	}
+	if !NewPersons(nil).All(startsWithB) || NewPersons(nil).Any(hasName) {
+		t.Errorf("All()/Any() of empty = wrong result")
+	}
+	if got := p.Count(startsWithB); got != 2 {
+		t.Errorf("Count() = %v, want 2", got)
+	}
+	if got, ok := p.Find(startsWithB); !ok || got.Name != "Bob" {
+		t.Errorf("Find() = %v, %v, want Bob, true", got, ok)
+	}
+	if got := p.Index(startsWithB); got != 1 {
+		t.Errorf("Index() = %v, want 1", got)
+	}
+	if got := p.Index(func(p Person) bool { return p.Name == "David" }); got != -1 {
+		t.Errorf("Index() = %v, want -1", got)
+	}
+}
+
+func TestPersons_GroupBy(t *testing.T) {
+	p := NewPersons([]Person{{Name: "Ann"}, {Name: "Bob"}, {Name: "Bea"}})
+	got := p.GroupBy(func(p Person) int { return len(p.Name) })
+	want := map[int][]Person{3: {{Name: "Ann"}, {Name: "Bob"}, {Name: "Bea"}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupBy() = %v, want %v", got, want)
+	}
+}
+
+func TestPersons_ByName(t *testing.T) {
+	ann := Person{Name: "Ann", Birthdate: time.Unix(1, 0)}
+	bob := Person{Name: "Bob"}
+	p := NewPersons([]Person{ann, bob, {Name: "Ann"}})
+	want := map[string]Person{"Ann": ann, "Bob": bob}
+	if got := p.ByName(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ByName() = %v, want %v", got, want)
+	}
+	if got, ok := p.FindByName("Ann"); !ok || got != ann {
+		t.Errorf("FindByName() = %v, %v, want %v, true", got, ok, ann)
+	}
+	if _, ok := p.FindByName("David"); ok {
+		t.Errorf("FindByName() = _, true, want false")
+	}
+}
+
+func TestPersons_Chain(t *testing.T) {
+	p := NewPersons([]Person{{Name: "Bob"}, {Name: "Ann"}, {Name: "Bob"}, {Name: "Bea"}, {Name: "Cid"}})
+	startsWithB := func(p Person) bool { return p.Name[0] == 'B' }
+
+	matched, rest := p.Distinct().Partition(startsWithB)
+	if want := []Person{{Name: "Bob"}, {Name: "Bea"}}; !reflect.DeepEqual(matched.Result(), want) {
+		t.Errorf("Partition() matched = %v, want %v", matched.Result(), want)
+	}
+	if want := []Person{{Name: "Ann"}, {Name: "Cid"}}; !reflect.DeepEqual(rest.Result(), want) {
+		t.Errorf("Partition() rest = %v, want %v", rest.Result(), want)
+	}
+
+	chunks := rest.Chunk(1)
+	if len(chunks) != 2 || chunks[1].Count(startsWithB) != 0 || chunks[1].Result()[0].Name != "Cid" {
+		t.Errorf("Chunk() = %v, want [[Ann] [Cid]]", chunks)
+	}
+}
+
+func TestRoster_All(t *testing.T) {
+	r := NewRoster([]Person{{Name: "Ann"}, {Name: "Bob"}, {Name: "Bea"}})
+
+	var names []string
+	for p := range r.All() {
+		names = append(names, p.Name)
+	}
+	if want := []string{"Ann", "Bob", "Bea"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("All() = %v, want %v", names, want)
+	}
+
+	names = nil
+	for p := range r.FilterSeq(func(p Person) bool { return p.Name[0] == 'B' }) {
+		names = append(names, p.Name)
+		break
+	}
+	if want := []string{"Bob"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("FilterSeq() = %v, want %v", names, want)
+	}
+
+	names = nil
+	for name := range r.MapToStringSeq(Person.String) {
+		names = append(names, name)
+	}
+	if want := []string{"Ann", "Bob", "Bea"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("MapToStringSeq() = %v, want %v", names, want)
+	}
+}
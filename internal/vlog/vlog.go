@@ -0,0 +1,22 @@
+// Package vlog provides leveled logging
+package vlog
+
+import "log"
+
+// Level is the verbosity threshold; messages logged at a higher level are discarded
+var Level int
+
+// Verbose answers whether logging is enabled at a particular level
+type Verbose bool
+
+// V returns whether logging is enabled at the given level
+func V(level int) Verbose {
+	return Verbose(level <= Level)
+}
+
+// Printf logs the message if enabled
+func (v Verbose) Printf(format string, a ...interface{}) {
+	if v {
+		log.Printf(format, a...)
+	}
+}
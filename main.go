@@ -1,36 +1,76 @@
 package main
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"flag"
 	"fmt"
 	"go/ast"
+	"go/importer"
 	"go/parser"
 	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
 	"regexp"
+	"runtime"
+	"runtime/debug"
 	"strings"
 	"unicode/utf8"
 
 	"golang.org/x/tools/go/packages"
 
 	"github.com/phelmkamp/metatag/directive"
+	"github.com/phelmkamp/metatag/internal/vlog"
 	"github.com/phelmkamp/metatag/meta"
 )
 
-const (
-	accessTemplate = "%s.%s"
-)
-
 var (
 	goFileRegEx  = regexp.MustCompile(`.+\.go$`)
 	metaTagRegEx = regexp.MustCompile(`meta:".+"`)
+	// header of files generated by metatag, including the one used by earlier versions
+	genFileRegEx = regexp.MustCompile(`^// (Code generated by metatag .*; DO NOT EDIT\.\r?\n|GENERATED BY metatag)`)
+
+	// known GOOS and GOARCH values (see go/build/syslist.go)
+	knownOS   = setOf("aix android darwin dragonfly freebsd hurd illumos ios js linux nacl netbsd openbsd plan9 solaris wasip1 windows zos")
+	knownArch = setOf("386 amd64 amd64p32 arm armbe arm64 arm64be loong64 mips mipsle mips64 mips64le mips64p32 mips64p32le ppc ppc64 ppc64le riscv riscv64 s390 s390x sparc sparc64 wasm")
 )
 
+// setOf returns the set of the given space-separated words.
+func setOf(words string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range strings.Fields(words) {
+		set[w] = true
+	}
+	return set
+}
+
+// metaFileName returns the name of the file to generate for the given source file.
+// A _GOOS, _GOARCH or _GOOS_GOARCH suffix is kept at the end of the name
+// so that the implicit build constraint still applies (e.g. foo_linux.go -> foo_meta_linux.go).
+func metaFileName(origPath string) string {
+	base := strings.TrimSuffix(origPath, ".go")
+	parts := strings.Split(filepath.Base(base), "_")
+
+	n := 0
+	if l := len(parts); l > 2 && knownOS[parts[l-2]] && knownArch[parts[l-1]] {
+		n = 2
+	} else if l > 1 && (knownOS[parts[l-1]] || knownArch[parts[l-1]]) {
+		n = 1
+	}
+	suffix := strings.Join(parts[len(parts)-n:], "_")
+	if suffix == "" {
+		return base + "_meta.go"
+	}
+	return strings.TrimSuffix(base, "_"+suffix) + "_meta_" + suffix + ".go"
+}
+
 func initFile(origPath string) *os.File {
-	filename := strings.Replace(origPath, ".go", "_meta.go", 1)
-	log.Printf("Creating file: %s\n", filename)
+	filename := metaFileName(origPath)
+	vlog.V(1).Printf("Creating file: %s\n", filename)
 	f, err := os.Create(filename)
 	if err != nil {
 		log.Fatalf("os.Create() failed: %v\n", err)
@@ -38,6 +78,45 @@ func initFile(origPath string) *os.File {
 	return f
 }
 
+// version returns the module version of the running binary.
+func version() string {
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" {
+		return info.Main.Version
+	}
+	return "(devel)"
+}
+
+// constraints returns the build constraint lines of the given file.
+func constraints(astFile *ast.File) []string {
+	var lines []string
+	for _, cg := range astFile.Comments {
+		if cg.Pos() >= astFile.Package {
+			break
+		}
+		for _, c := range cg.List {
+			if strings.HasPrefix(c.Text, "//go:build ") || strings.HasPrefix(c.Text, "// +build ") {
+				lines = append(lines, c.Text)
+			}
+		}
+	}
+	return lines
+}
+
+// pkgNames returns the names of the packages referenced by the given type expression.
+func pkgNames(expr ast.Expr) []string {
+	var names []string
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				names = append(names, id.Name)
+			}
+			return false
+		}
+		return true
+	})
+	return names
+}
+
 func first(s string) (string, int) {
 	if s == "" {
 		return "", 0
@@ -56,141 +135,380 @@ func upperFirst(s string) string {
 	return strings.ToUpper(f) + s[n:]
 }
 
-func main() {
-	var root string
-	flag.StringVar(&root, "path", ".", "directory path to scan for *.go files")
-	flag.Parse()
+// loadMode is the information that is loaded for the package of each tagged file.
+// Dependencies are imported from their export data, which go list produces for module-local and third-party packages alike.
+const loadMode = packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedDeps | packages.NeedExportsFile
+
+// pkgCache holds the packages that have been loaded, by the absolute paths of their files.
+type pkgCache map[string]*packages.Package
 
-	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+// load returns the package that contains the given file, with type information.
+func (pc pkgCache) load(path string, overlay map[string][]byte, tags string) (*packages.Package, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("filepath.Abs() failed: %w", err)
+	}
+	if pkg, ok := pc[absPath]; ok && overlay == nil {
+		return pkg, nil
+	}
+
+	// load from the directory of the file, which may belong to another module than the working directory
+	pkgCfg := &packages.Config{Mode: loadMode, Dir: filepath.Dir(absPath), Overlay: overlay}
+	if tags != "" {
+		pkgCfg.BuildFlags = []string{"-tags=" + tags}
+	}
+	// load the whole package, or just the file if it is not part of one (e.g. a test file)
+	pkgs, err := packages.Load(pkgCfg, "file="+absPath)
+	if err == nil && len(pkgs) == 0 {
+		pkgs, err = packages.Load(pkgCfg, absPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) == 0 {
+		return nil, fmt.Errorf("no package found for file: %s", path)
+	}
+	pkg := pkgs[0]
+	pkg.Types = typeCheck(pkg, overlay)
+
+	if overlay == nil {
+		for _, f := range pkg.GoFiles {
+			pc[f] = pkg
+		}
+	}
+	return pkg, nil
+}
+
+// typeCheck returns the given package with type information.
+// Type errors (e.g. in stale generated files) are ignored, so the information may be incomplete.
+func typeCheck(pkg *packages.Package, overlay map[string][]byte) *types.Package {
+	// export data by import path, as well as by package path for indirect dependencies
+	exports := make(map[string]string)
+	for importPath, imp := range pkg.Imports {
+		exports[importPath] = imp.ExportFile
+	}
+	packages.Visit([]*packages.Package{pkg}, nil, func(p *packages.Package) {
+		if p.ExportFile != "" {
+			exports[p.PkgPath] = p.ExportFile
+		}
+	})
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, path := range pkg.GoFiles {
+		src, ok := overlay[path]
+		if !ok {
+			var err error
+			if src, err = ioutil.ReadFile(path); err != nil {
+				vlog.V(1).Printf("Skipping file: %s (%v)\n", path, err)
+				continue
+			}
+		}
+		if genFileRegEx.Match(src) {
+			// generated methods are derived from meta tags instead, so stale files don't matter
+			vlog.V(2).Printf("Skipping generated file: %s\n", path)
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, src, 0)
 		if err != nil {
-			return err
+			vlog.V(1).Printf("Skipping file: %s (%v)\n", path, err)
+			continue
+		}
+		files = append(files, f)
+	}
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+			exportFile, ok := exports[path]
+			if !ok || exportFile == "" {
+				return nil, fmt.Errorf("no export data for package: %s", path)
+			}
+			return os.Open(exportFile)
+		}),
+		Sizes: types.SizesFor("gc", runtime.GOARCH),
+		Error: func(err error) {
+			vlog.V(2).Printf("Ignoring type error: %v\n", err)
+		},
+	}
+	typesPkg, _ := conf.Check(pkg.PkgPath, fset, files, nil)
+	return typesPkg
+}
+
+// inDir answers whether the given file is directly in the given directory.
+func inDir(path, dir string) bool {
+	absPath, err := filepath.Abs(path)
+	return err == nil && filepath.Dir(absPath) == dir
+}
+
+// config holds the settings that apply to every generated file.
+type config struct {
+	version    string
+	pkg        string
+	pkgDir     string
+	tags       string
+	provenance bool
+	stdout     bool
+	stdin      bool
+	manifest   *manifest
+	pkgs       pkgCache
+}
+
+// generate creates the meta file for the given source file, if it has any meta tags.
+// The file is read from disk unless its contents are given.
+func generate(path string, src []byte, cfg config) error {
+	vlog.V(1).Printf("Parsing file: %s\n", path)
+	var overlay map[string][]byte
+	if src == nil {
+		var err error
+		if src, err = ioutil.ReadFile(path); err != nil {
+			return fmt.Errorf("ioutil.ReadFile() failed: %w", err)
+		}
+	} else {
+		// unsaved contents take the place of the file on disk
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return fmt.Errorf("filepath.Abs() failed: %w", err)
+		}
+		overlay = map[string][]byte{absPath: src}
+	}
+	fset := token.NewFileSet()
+	astFile, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("parser.ParseFile() failed: %w", err)
+	}
+
+	if cfg.pkg != "" && astFile.Name.Name != cfg.pkg && inDir(path, cfg.pkgDir) {
+		vlog.V(1).Printf("Skipping file: %s (package %s)\n", path, astFile.Name.Name)
+		return nil
+	}
+	if !metaTagRegEx.Match(src) {
+		vlog.V(1).Printf("Skipping file: %s (no meta tags)\n", path)
+		return nil
+	}
+
+	hash := sha256.Sum256(src)
+	tgt := directive.Target{
+		MetaFile: meta.NewFile(astFile.Name.Name),
+	}
+	tgt.MetaFile.Version = cfg.version
+	tgt.MetaFile.Source = filepath.Base(path)
+	tgt.MetaFile.Hash = hex.EncodeToString(hash[:])
+	tgt.MetaFile.Constraints = constraints(astFile)
+	pkg, err := cfg.pkgs.load(path, overlay, cfg.tags)
+	if err != nil {
+		return err
+	}
+	importPaths := pkg.Imports
+	tgt.Pkg = pkg.Types
+
+	fm := &fileManifest{Source: path}
+	// package name by import path of the field types
+	fldImports := make(map[string]string)
+
+	ast.Inspect(astFile, func(n ast.Node) bool {
+		var expr ast.Expr
+		switch nt := n.(type) {
+		case *ast.TypeSpec:
+			expr = nt.Type
+			tgt.RcvType = nt.Name.Name
+		}
+
+		if expr == nil {
+			return true
 		}
-		if !info.IsDir() && goFileRegEx.MatchString(info.Name()) {
-			cleanPath := filepath.Clean(path)
 
-			log.Printf("Parsing file: %s\n", cleanPath)
-			fset := token.NewFileSet()
-			astFile, err := parser.ParseFile(fset, cleanPath, nil, 0)
-			if err != nil {
-				return fmt.Errorf("parser.ParseFile() failed: %w", err)
+		st, ok := expr.(*ast.StructType)
+		if !ok {
+			return true
+		}
+
+		vlog.V(2).Printf("Found struct: %s\n", tgt.RcvType)
+
+		tgt.RcvName, _ = first(tgt.RcvType)
+		tgt.RcvName = strings.ToLower(tgt.RcvName)
+
+		for _, f := range st.Fields.List {
+			if f.Tag == nil {
+				continue
 			}
 
-			tgt := directive.Target{
-				MetaFile: meta.NewFile(astFile.Name.Name),
+			metaTag := metaTagRegEx.FindString(f.Tag.Value)
+			if metaTag == "" {
+				continue
 			}
-			cfg := &packages.Config{Mode: packages.NeedName | packages.NeedImports}
-			pkgs, err := packages.Load(cfg, cleanPath)
-			if err != nil {
-				return err
+
+			vlog.V(2).Printf("Found meta tag %s\n", metaTag)
+			metaTag = strings.TrimPrefix(metaTag, "meta:\"")
+			metaTag = strings.TrimSuffix(metaTag, "\"")
+
+			pos := fmt.Sprintf("%s:%d", filepath.Base(path), fset.Position(f.Pos()).Line)
+			fi := fieldInfo{Pos: pos, Directives: newDirectiveInfos(metaTag)}
+
+			// some directives modify target, use a local copy
+			fldTgt := tgt
+
+			fldTgt.FldType = types.ExprString(f.Type)
+			if cfg.provenance {
+				fldTgt.Pos = pos
 			}
-			importPaths := pkgs[0].Imports
-
-			ast.Inspect(astFile, func(n ast.Node) bool {
-				var expr ast.Expr
-				switch nt := n.(type) {
-				case *ast.TypeSpec:
-					expr = nt.Type
-					tgt.RcvType = nt.Name.Name
-				}
 
-				if expr == nil {
-					return true
-				}
+			fldTgt.FldNames = make([]string, len(f.Names))
+			for i := range f.Names {
+				fldTgt.FldNames[i] = f.Names[i].Name
+			}
+			fi.Names = fldTgt.FldNames
+
+			directive.RunAll(strings.Split(metaTag, ";"), &fldTgt)
 
-				st, ok := expr.(*ast.StructType)
-				if !ok {
-					return true
+			si := fm.addStruct(tgt.RcvType)
+			si.Fields = append(si.Fields, fi)
+			fm.addDiagnostics(pos, fldTgt.Diagnostics...)
+
+			for _, fldPkg := range pkgNames(f.Type) {
+				var importPath string
+				for _, p := range importPaths {
+					if p.Name == fldPkg {
+						importPath = p.PkgPath
+					}
 				}
+				if importPath == "" {
+					vlog.V(0).Printf("Unknown package: %s\n", fldPkg)
+					fm.addDiagnostics(pos, "Unknown package: "+fldPkg)
+				}
+				fldImports[importPath] = fldPkg
+			}
+		}
 
-				log.Printf("Found struct: %s\n", tgt.RcvType)
+		return true
+	})
 
-				tgt.RcvName, _ = first(tgt.RcvType)
-				tgt.RcvName = strings.ToLower(tgt.RcvName)
+	if cfg.manifest != nil && (len(fm.Structs) > 0 || len(fm.Diagnostics) > 0) {
+		cfg.manifest.Files = append(cfg.manifest.Files, fm)
+	}
 
-				for _, f := range st.Fields.List {
-					if f.Tag == nil {
-						continue
-					}
+	if len(tgt.MetaFile.Methods) < 1 {
+		return nil
+	}
 
-					metaTag := metaTagRegEx.FindString(f.Tag.Value)
-					if metaTag == "" {
-						continue
-					}
+	// field types only need to be imported if the generated code refers to them
+	body := tgt.MetaFile.Types.String() + tgt.MetaFile.Methods.String()
+	for importPath, fldPkg := range fldImports {
+		if regexp.MustCompile(`\b` + regexp.QuoteMeta(fldPkg) + `\.`).MatchString(body) {
+			vlog.V(2).Printf("Adding import: \"%s\"\n", importPath)
+			tgt.MetaFile.Imports[importPath] = struct{}{}
+		}
+	}
 
-					log.Printf("Found meta tag %s\n", metaTag)
-					metaTag = strings.TrimPrefix(metaTag, "meta:\"")
-					metaTag = strings.TrimSuffix(metaTag, "\"")
-
-					// some directives modify target, use a local copy
-					fldTgt := tgt
-
-					var fldPkg string
-					switch ft := f.Type.(type) {
-					case *ast.Ident:
-						fldTgt.FldType = ft.Name
-					case *ast.SelectorExpr:
-						// package.type
-						fldPkg = ft.X.(*ast.Ident).Name
-						fldTgt.FldType = fmt.Sprintf(accessTemplate, fldPkg, ft.Sel.Name)
-					case *ast.ArrayType:
-						switch elt := ft.Elt.(type) {
-						case *ast.Ident:
-							fldTgt.FldType = "[]" + elt.Name
-						case *ast.SelectorExpr:
-							// package.type
-							fldPkg = elt.X.(*ast.Ident).Name
-							fldTgt.FldType = fmt.Sprintf(accessTemplate, "[]"+fldPkg, elt.Sel.Name)
-						}
-					case *ast.MapType:
-						fldTgt.FldType = fmt.Sprintf("map[%s]%s", ft.Key.(*ast.Ident).Name, ft.Value.(*ast.Ident).Name)
-					case interface{}:
-						fldTgt.FldType = "interface{}"
-					default:
-						log.Printf("Unsupported field type: %v\n", ft)
-						continue
-					}
+	fm.Generated = metaFileName(path)
+	code := tgt.MetaFile.String()
+	if cfg.manifest != nil {
+		if err := fm.addSymbols(fm.Generated, code); err != nil {
+			return err
+		}
+	}
 
-					fldTgt.FldNames = make([]string, len(f.Names))
-					for i := range f.Names {
-						fldTgt.FldNames[i] = f.Names[i].Name
-					}
+	if cfg.stdout || cfg.stdin {
+		if !cfg.stdin {
+			fmt.Printf("==> %s <==\n", fm.Generated)
+		}
+		fmt.Print(code)
+		return nil
+	}
 
-					directive.RunAll(strings.Split(metaTag, ";"), &fldTgt)
-
-					if fldPkg != "" {
-						var importPath string
-						for _, p := range importPaths {
-							if p.Name == fldPkg {
-								importPath = p.PkgPath
-							}
-						}
-						log.Printf("Adding import: \"%s\"\n", importPath)
-						tgt.MetaFile.Imports[importPath] = struct{}{}
-					}
-				}
+	osFile := initFile(path)
+	defer func() {
+		if err := osFile.Close(); err != nil {
+			log.Printf("File.Close() failed: %v\n", err)
+		}
+	}()
 
-				return true
-			})
+	if _, err := osFile.WriteString(code); err != nil {
+		log.Fatalf("File.WriteString() failed: %v\n", err)
+	}
 
-			if len(tgt.MetaFile.Methods) < 1 {
-				return nil
-			}
+	return nil
+}
 
-			osFile := initFile(cleanPath)
-			defer func() {
-				if err := osFile.Close(); err != nil {
-					log.Printf("File.Close() failed: %v\n", err)
-				}
-			}()
+func main() {
+	var root, file string
+	var recursive, asJSON bool
+	var cfg config
+	flag.StringVar(&root, "path", ".", "directory path to scan for *.go files")
+	flag.StringVar(&file, "file", "", "single *.go file to process (e.g. $GOFILE); overrides --path")
+	flag.BoolVar(&recursive, "recursive", false, "scan subdirectories of --path as well")
+	flag.StringVar(&cfg.tags, "tags", "", "comma-separated list of build tags to consider satisfied when loading packages")
+	flag.BoolVar(&cfg.provenance, "provenance", false, "annotate each generated method with the tag that produced it")
+	flag.BoolVar(&cfg.stdout, "stdout", false, "print the generated files instead of writing them")
+	flag.BoolVar(&cfg.stdin, "stdin", false, "read the contents of --file from stdin and print the generated file")
+	flag.IntVar(&vlog.Level, "v", 0, "verbosity level (1: files, 2: structs, tags and methods)")
+	flag.BoolVar(&asJSON, "json", false, "print a manifest of the structs, directives, generated symbols and diagnostics as JSON")
+	flag.Parse()
 
-			if _, err := osFile.WriteString(tgt.MetaFile.String()); err != nil {
-				log.Fatalf("File.WriteString() failed: %v\n", err)
+	if asJSON {
+		if cfg.stdout || cfg.stdin {
+			log.Fatal("--json cannot be combined with --stdout or --stdin")
+		}
+		cfg.manifest = &manifest{Files: []*fileManifest{}}
+		defer func() {
+			if err := cfg.manifest.write(os.Stdout); err != nil {
+				log.Fatalf("manifest.write() failed: %v\n", err)
 			}
+		}()
+	}
+
+	cfg.version = version()
+	cfg.pkgs = make(pkgCache)
+
+	if cfg.stdin {
+		if file == "" {
+			log.Fatal("--stdin requires --file")
 		}
+		src, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			log.Fatalf("ioutil.ReadAll() failed: %v\n", err)
+		}
+		if err := generate(filepath.Clean(file), src, cfg); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 
-		return nil
-	})
-	if err != nil {
+	if file != "" {
+		if err := generate(filepath.Clean(file), nil, cfg); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if err := walk(filepath.Clean(root), recursive, cfg); err != nil {
 		log.Fatal(err)
 	}
 }
+
+// walk generates the meta files for the *.go files in root and, if recursive, its subdirectories.
+func walk(root string, recursive bool, cfg config) error {
+	// under go generate, only process files of the package that contains the directive,
+	// which is the package in the working directory; subdirectories hold other packages.
+	// $GOFILE is ignored, since --file=$GOFILE is the way to process just the file of the directive
+	if cfg.pkg = os.Getenv("GOPACKAGE"); cfg.pkg != "" {
+		var err error
+		if cfg.pkgDir, err = os.Getwd(); err != nil {
+			return fmt.Errorf("os.Getwd() failed: %w", err)
+		}
+	}
+
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if !recursive && path != root {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if goFileRegEx.MatchString(info.Name()) {
+			return generate(filepath.Clean(path), nil, cfg)
+		}
+		return nil
+	})
+}
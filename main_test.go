@@ -0,0 +1,363 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeModule writes the given files to a new temporary directory and returns its path.
+func writeModule(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "metatag")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestWalk_goGenerate(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"go.mod": "module example.com/walk\n\ngo 1.13\n",
+		"main.go": "package main\n\n//go:generate metatag --recursive\n\n" +
+			"type Foo struct {\n\tname string `meta:\"getter\"`\n}\n\nfunc main() {}\n",
+		// a file of another package in the directory of the directive is skipped
+		"tools.go": "//go:build ignore\n\npackage tools\n\n" +
+			"type Tool struct {\n\tname string `meta:\"getter\"`\n}\n",
+		// subpackages are processed regardless of their name
+		filepath.Join("bar", "bar.go"): "package bar\n\n" +
+			"type Bar struct {\n\tname string `meta:\"getter\"`\n}\n",
+	})
+	defer os.RemoveAll(dir)
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv("GOPACKAGE")
+	os.Setenv("GOPACKAGE", "main")
+
+	if err := walk(".", true, config{pkgs: make(pkgCache)}); err != nil {
+		t.Fatalf("walk() failed: %v", err)
+	}
+
+	for name, want := range map[string]bool{"main_meta.go": true, "tools_meta.go": false, filepath.Join("bar", "bar_meta.go"): true} {
+		if _, err := os.Stat(filepath.Join(dir, name)); (err == nil) != want {
+			t.Errorf("%s generated = %v, want %v", name, err == nil, want)
+		}
+	}
+}
+
+func TestWalk_provenance(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"go.mod": "module example.com/provenance\n\ngo 1.13\n",
+		"foo.go": "package foo\n\ntype Foo struct {\n" +
+			"\tnames []string `meta:\"equal;filter\"`\n" +
+			"\tsize  int      `meta:\"equal\"`\n}\n",
+	})
+	defer os.RemoveAll(dir)
+
+	if err := walk(dir, false, config{pkgs: make(pkgCache), provenance: true}); err != nil {
+		t.Fatalf("walk() failed: %v", err)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, "foo_meta.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := strings.Replace(string(b), "\r", "", -1)
+
+	for _, want := range []string{
+		// every field that contributes to a method is recorded
+		"\n// metatag: foo.go:4 equal\n// metatag: foo.go:5 equal\n\n// Equal answers",
+		// every method of a template is annotated
+		"\n// metatag: foo.go:4 filter\n\n// FilterNames returns",
+		"\n// metatag: foo.go:4 filter\n\n// FilterNamesN returns",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, code)
+		}
+	}
+}
+
+func TestMetaFileName(t *testing.T) {
+	tests := map[string]string{
+		"foo.go":             "foo_meta.go",
+		"foo_linux.go":       "foo_meta_linux.go",
+		"foo_amd64.go":       "foo_meta_amd64.go",
+		"foo_linux_amd64.go": "foo_meta_linux_amd64.go",
+		"foo_test_linux.go":  "foo_test_meta_linux.go",
+		// fragments of GOOS and GOARCH values are not suffixes
+		"foo_os.go":    "foo_os_meta.go",
+		"foo_ux.go":    "foo_ux_meta.go",
+		"foo_64.go":    "foo_64_meta.go",
+		"foo_os_64.go": "foo_os_64_meta.go",
+	}
+	for path, want := range tests {
+		if got := metaFileName(path); got != want {
+			t.Errorf("metaFileName(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestWalk_parallelWorkers(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"go.mod": "module example.com/parallel\n\ngo 1.13\n",
+		"foo.go": "package foo\n\ntype Foo struct {\n" +
+			"\tnone []int `meta:\"filter,parallel=0\"`\n" +
+			"\tneg  []int `meta:\"filter,parallel=-2\"`\n" +
+			"\tnan  []int `meta:\"mapper,string,parallel=n\"`\n" +
+			"\ttwo  []int `meta:\"filter,parallel=2\"`\n}\n",
+	})
+	defer os.RemoveAll(dir)
+
+	cfg := config{pkgs: make(pkgCache), manifest: &manifest{}}
+	if err := walk(dir, false, cfg); err != nil {
+		t.Fatalf("walk() failed: %v", err)
+	}
+	if len(cfg.manifest.Files) != 1 || len(cfg.manifest.Files[0].Diagnostics) != 3 {
+		t.Errorf("want 3 diagnostics, got %+v", cfg.manifest.Files)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, "foo_meta.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// invalid numbers of goroutines fall back to GOMAXPROCS
+	code := string(b)
+	if got := strings.Count(code, "worker < runtime.GOMAXPROCS(0);"); got != 3 {
+		t.Errorf("GOMAXPROCS workers = %d, want 3:\n%s", got, code)
+	}
+	if !strings.Contains(code, "worker < 2;") {
+		t.Errorf("generated code does not use 2 workers:\n%s", code)
+	}
+}
+
+func TestWalk_staleMetaFile(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"go.mod": "module example.com/stale\n\ngo 1.13\n",
+		"foo.go": "package foo\n\ntype Foo struct {\n\tname string\n}\n\n" +
+			"type Bar struct {\n\tfoo Foo `meta:\"equal\"`\n}\n",
+		// Foo used to be tagged with equal
+		"foo_meta.go": "// Code generated by metatag v0.1.0 from foo.go; DO NOT EDIT.\n\npackage foo\n\n" +
+			"func (f Foo) Equal(f2 Foo) bool {\n\treturn f.name == f2.name\n}\n",
+	})
+	defer os.RemoveAll(dir)
+
+	if err := walk(dir, false, config{pkgs: make(pkgCache)}); err != nil {
+		t.Fatalf("walk() failed: %v", err)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, "foo_meta.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code := string(b); !strings.Contains(code, "b.foo != b2.foo") {
+		t.Errorf("generated code relies on the stale Equal method:\n%s", code)
+	}
+}
+
+func TestWalk_hashEpsilon(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"go.mod": "module example.com/epsilon\n\ngo 1.13\n",
+		"foo.go": "package foo\n\ntype Foo struct {\n" +
+			"\tname  string  `meta:\"equal;hash\"`\n" +
+			"\tscale float64 `meta:\"hash;equal,epsilon=1e-9\"`\n}\n",
+	})
+	defer os.RemoveAll(dir)
+
+	cfg := config{pkgs: make(pkgCache), manifest: &manifest{}}
+	if err := walk(dir, false, cfg); err != nil {
+		t.Fatalf("walk() failed: %v", err)
+	}
+	if len(cfg.manifest.Files) != 1 || len(cfg.manifest.Files[0].Diagnostics) != 1 {
+		t.Errorf("want 1 diagnostic, got %+v", cfg.manifest.Files)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, "foo_meta.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a field compared within epsilon is not hashed
+	if code := string(b); strings.Contains(code, "float64(f.scale)") {
+		t.Errorf("generated code hashes scale:\n%s", code)
+	}
+}
+
+func TestWalk_iterAll(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"go.mod": "module example.com/iterall\n\ngo 1.23\n",
+		"foo.go": "package foo\n\ntype Foo struct {\n" +
+			"\tnames []string `meta:\"all;iter\"`\n" +
+			"\tsizes []int    `meta:\"all,omitfield;iter\"`\n}\n",
+	})
+	defer os.RemoveAll(dir)
+
+	cfg := config{pkgs: make(pkgCache), manifest: &manifest{}}
+	if err := walk(dir, false, cfg); err != nil {
+		t.Fatalf("walk() failed: %v", err)
+	}
+	if len(cfg.manifest.Files) != 1 || len(cfg.manifest.Files[0].Diagnostics) != 1 {
+		t.Errorf("want 1 diagnostic, got %+v", cfg.manifest.Files)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, "foo_meta.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the iterator takes the name of the clashing predicate, but not of the one without the field name
+	code := string(b)
+	for _, want := range []string{"func (f Foo) AllNames() iter.Seq[string]", "func (f Foo) AllSizes() iter.Seq[int]", "func (f Foo) All(fn func(int) bool) bool"} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated code lacks %q:\n%s", want, code)
+		}
+	}
+}
+
+// captureStdout returns what the given function prints to standard output.
+func captureStdout(t *testing.T, fn func()) string {
+	f, err := ioutil.TempFile("", "stdout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	stdout := os.Stdout
+	os.Stdout = f
+	defer func() { os.Stdout = stdout }()
+	fn()
+
+	b, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestWalk_stdout(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"go.mod": "module example.com/stdout\n\ngo 1.13\n",
+		"foo.go": "package foo\n\ntype Foo struct {\n\tname string `meta:\"getter\"`\n}\n",
+	})
+	defer os.RemoveAll(dir)
+
+	var err error
+	out := captureStdout(t, func() {
+		err = walk(dir, false, config{pkgs: make(pkgCache), stdout: true})
+	})
+	if err != nil {
+		t.Fatalf("walk() failed: %v", err)
+	}
+	for _, want := range []string{"foo_meta.go <==\n", "func (f Foo) Name() string {"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q:\n%s", want, out)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "foo_meta.go")); err == nil {
+		t.Errorf("foo_meta.go written, want only printed")
+	}
+}
+
+func TestGenerate_stdin(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"go.mod": "module example.com/stdin\n\ngo 1.13\n",
+		"foo.go": "package foo\n\ntype Foo struct {\n\tname string `meta:\"getter\"`\n}\n",
+	})
+	defer os.RemoveAll(dir)
+
+	// the unsaved contents declare a type that only type information from the overlay knows about
+	src := "package foo\n\ntype Size int\n\ntype Foo struct {\n\tsize Size `meta:\"equal\"`\n}\n"
+	cfg := config{pkgs: make(pkgCache), stdin: true, manifest: &manifest{}}
+	var err error
+	out := captureStdout(t, func() {
+		err = generate(filepath.Join(dir, "foo.go"), []byte(src), cfg)
+	})
+	if err != nil {
+		t.Fatalf("generate() failed: %v", err)
+	}
+	if strings.Contains(out, "==>") || !strings.Contains(out, "if f.size != f2.size {") {
+		t.Errorf("output is not the file generated from the unsaved contents:\n%s", out)
+	}
+	if len(cfg.manifest.Files) != 1 || len(cfg.manifest.Files[0].Diagnostics) != 0 {
+		t.Errorf("want no diagnostics, got %+v", cfg.manifest.Files)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "foo_meta.go")); err == nil {
+		t.Errorf("foo_meta.go written, want only printed")
+	}
+}
+
+func TestWalk_manifest(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"go.mod": "module example.com/manifest\n\ngo 1.13\n",
+		"foo.go": "package foo\n\ntype Foo struct {\n" +
+			"\tname, alias string `meta:\"getter;setter,ptr\"`\n" +
+			"\tsize int\n" +
+			"\tids []int `meta:\"filter,parallel=0\"`\n}\n",
+	})
+	defer os.RemoveAll(dir)
+
+	cfg := config{pkgs: make(pkgCache), manifest: &manifest{Files: []*fileManifest{}}}
+	if err := walk(dir, false, cfg); err != nil {
+		t.Fatalf("walk() failed: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := cfg.manifest.write(&buf); err != nil {
+		t.Fatalf("manifest.write() failed: %v", err)
+	}
+
+	var got manifest
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal() failed: %v\n%s", err, buf.String())
+	}
+	if len(got.Files) != 1 {
+		t.Fatalf("want 1 file, got %s", buf.String())
+	}
+	fm := got.Files[0]
+	if fm.Source != filepath.Join(dir, "foo.go") || fm.Generated != filepath.Join(dir, "foo_meta.go") {
+		t.Errorf("source, generated = %q, %q, want foo.go and foo_meta.go in %s", fm.Source, fm.Generated, dir)
+	}
+	wantStructs := []*structInfo{{
+		Name: "Foo",
+		Fields: []fieldInfo{
+			{
+				Names:      []string{"name", "alias"},
+				Pos:        "foo.go:4",
+				Directives: []directiveInfo{{Name: "getter"}, {Name: "setter", Options: []string{"ptr"}}},
+			},
+			{
+				Names:      []string{"ids"},
+				Pos:        "foo.go:6",
+				Directives: []directiveInfo{{Name: "filter", Options: []string{"parallel=0"}}},
+			},
+		},
+	}}
+	if !reflect.DeepEqual(fm.Structs, wantStructs) {
+		t.Errorf("structs = %s", buf.String())
+	}
+	if len(fm.Diagnostics) != 1 || fm.Diagnostics[0].Pos != "foo.go:6" {
+		t.Errorf("want 1 diagnostic at foo.go:6, got %+v", fm.Diagnostics)
+	}
+
+	var names []string
+	for _, sym := range fm.Symbols {
+		if sym.Kind == "method" && sym.Receiver == "*Foo" {
+			names = append(names, sym.Name)
+		}
+	}
+	if want := []string{"SetName", "SetAlias"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("methods of *Foo = %v, want %v", names, want)
+	}
+}
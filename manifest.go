@@ -0,0 +1,134 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// manifest describes the results of a run in machine-readable form
+type manifest struct {
+	Files []*fileManifest `json:"files"`
+}
+
+// fileManifest describes the generation of a single meta file
+type fileManifest struct {
+	Source      string           `json:"source"`
+	Generated   string           `json:"generated,omitempty"`
+	Structs     []*structInfo    `json:"structs,omitempty"`
+	Symbols     []symbolInfo     `json:"symbols,omitempty"`
+	Diagnostics []diagnosticInfo `json:"diagnostics,omitempty"`
+}
+
+// structInfo describes a struct that has meta tags
+type structInfo struct {
+	Name   string      `json:"name"`
+	Fields []fieldInfo `json:"fields"`
+}
+
+// fieldInfo describes a tagged field
+type fieldInfo struct {
+	Names      []string        `json:"names"`
+	Pos        string          `json:"pos"`
+	Directives []directiveInfo `json:"directives"`
+}
+
+// directiveInfo describes a directive along with its options
+type directiveInfo struct {
+	Name    string   `json:"name"`
+	Options []string `json:"options,omitempty"`
+}
+
+// symbolInfo describes a generated declaration
+type symbolInfo struct {
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	Receiver string `json:"receiver,omitempty"`
+	Pos      string `json:"pos"`
+}
+
+// diagnosticInfo describes a problem encountered during generation
+type diagnosticInfo struct {
+	Pos     string `json:"pos,omitempty"`
+	Message string `json:"message"`
+}
+
+// newDirectiveInfos splits the given meta tag into its directives and options.
+func newDirectiveInfos(metaTag string) []directiveInfo {
+	ds := strings.Split(metaTag, ";")
+	infos := make([]directiveInfo, len(ds))
+	for i := range ds {
+		opts := strings.Split(ds[i], ",")
+		infos[i] = directiveInfo{Name: opts[0], Options: opts[1:]}
+	}
+	return infos
+}
+
+// addStruct returns the info for the named struct, adding it if necessary.
+func (fm *fileManifest) addStruct(name string) *structInfo {
+	for _, si := range fm.Structs {
+		if si.Name == name {
+			return si
+		}
+	}
+	si := &structInfo{Name: name}
+	fm.Structs = append(fm.Structs, si)
+	return si
+}
+
+// addDiagnostics records the given messages at the given position.
+func (fm *fileManifest) addDiagnostics(pos string, msgs ...string) {
+	for _, msg := range msgs {
+		fm.Diagnostics = append(fm.Diagnostics, diagnosticInfo{Pos: pos, Message: msg})
+	}
+}
+
+// addSymbols records the top-level declarations of the given generated code.
+func (fm *fileManifest) addSymbols(filename, code string) error {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, code, 0)
+	if err != nil {
+		return fmt.Errorf("parser.ParseFile() failed: %w", err)
+	}
+
+	base := filepath.Base(filename)
+	pos := func(p token.Pos) string {
+		return fmt.Sprintf("%s:%d", base, fset.Position(p).Line)
+	}
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			sym := symbolInfo{Name: d.Name.Name, Kind: "func", Pos: pos(d.Name.Pos())}
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				sym.Kind = "method"
+				sym.Receiver = types.ExprString(d.Recv.List[0].Type)
+			}
+			fm.Symbols = append(fm.Symbols, sym)
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					fm.Symbols = append(fm.Symbols, symbolInfo{Name: s.Name.Name, Kind: "type", Pos: pos(s.Name.Pos())})
+				case *ast.ValueSpec:
+					for _, n := range s.Names {
+						fm.Symbols = append(fm.Symbols, symbolInfo{Name: n.Name, Kind: d.Tok.String(), Pos: pos(n.Pos())})
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// write encodes the manifest as indented JSON.
+func (m *manifest) write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(m)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -11,7 +12,7 @@ import (
 )
 
 const (
-	topComment   = "// GENERATED BY metatag, DO NOT EDIT\n// (or edit away - I'm a comment, not a cop)\n\n"
+	topComment   = "// Code generated by metatag %s from %s; DO NOT EDIT.\n// (or edit away - I'm a comment, not a cop)\n// Inputs: sha256:%s\n\n"
 	fileTemplate = "package %s\n%s%s%s"
 )
 
@@ -21,6 +22,15 @@ type File struct {
 	Imports Imports
 	Types   Types
 	Methods Methods `meta:"ptr;filter"`
+
+	// Version is the version of metatag that generated the file
+	Version string
+	// Source is the name of the file that declares the tagged structs
+	Source string
+	// Hash is the hex-encoded SHA-256 of the source file contents
+	Hash string
+	// Constraints are the build constraint lines copied from the source file
+	Constraints []string
 }
 
 // NewFile creates a new File with all fields initialized
@@ -33,7 +43,17 @@ func NewFile(pkg string) *File {
 
 // String generates the file content
 func (f *File) String() string {
-	return topComment + fmt.Sprintf(fileTemplate, f.Package, f.Imports, f.Types, f.Methods)
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf(topComment, f.Version, f.Source, f.Hash))
+	for i := range f.Constraints {
+		sb.WriteString(f.Constraints[i])
+		sb.WriteString("\n")
+	}
+	if len(f.Constraints) > 0 {
+		sb.WriteString("\n")
+	}
+	sb.WriteString(fmt.Sprintf(fileTemplate, f.Package, f.Imports, f.Types, f.Methods))
+	return sb.String()
 }
 
 // Imports represents a set of import paths
@@ -45,9 +65,15 @@ func (is Imports) String() string {
 		return ""
 	}
 
+	paths := make([]string, 0, len(is))
+	for k := range is {
+		paths = append(paths, k)
+	}
+	sort.Strings(paths)
+
 	sb := strings.Builder{}
 	sb.WriteString("\nimport (\n")
-	for k := range is {
+	for _, k := range paths {
 		sb.WriteString("\t\"")
 		sb.WriteString(k)
 		sb.WriteString("\"\n")
@@ -92,11 +118,40 @@ type Method struct {
 	FldName, FldType string
 	Misc             map[string]interface{}
 	Tmpl             string
+	// Origins identify the tags that produced the method (e.g. "foo.go:12 getter")
+	Origins []string
 }
 
 // String generates the method code
 func (m Method) String() string {
-	return executeTmpl(m.Tmpl, m)
+	code := executeTmpl(m.Tmpl, m)
+	if len(m.Origins) == 0 {
+		return code
+	}
+
+	// place the provenance comments above the doc comment of each declaration,
+	// separated by a blank line so that they are not part of it
+	var provenance string
+	for _, origin := range m.Origins {
+		provenance += "// metatag: " + origin + "\n"
+	}
+	sb := strings.Builder{}
+	var doc []string
+	for _, line := range strings.SplitAfter(code, "\n") {
+		if strings.HasPrefix(line, "//") {
+			doc = append(doc, line)
+			continue
+		}
+		if strings.HasPrefix(line, "func ") {
+			sb.WriteString(provenance)
+			sb.WriteString("\n")
+		}
+		sb.WriteString(strings.Join(doc, ""))
+		doc = nil
+		sb.WriteString(line)
+	}
+	sb.WriteString(strings.Join(doc, ""))
+	return sb.String()
 }
 
 // Methods represents a collection of generated methods
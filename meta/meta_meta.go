@@ -1,11 +1,17 @@
-// GENERATED BY metatag, DO NOT EDIT
+// Code generated by metatag (devel) from meta.go; DO NOT EDIT.
 // (or edit away - I'm a comment, not a cop)
+// Inputs: sha256:3dd54136e253f428837c0cb5e17c826f87b566c7df7808028b8c362f0658353d
 
 package meta
 
 // FilterMethods returns a copy of Methods, omitting elements that are rejected by the given function.
+func (f *File) FilterMethods(fn func(*Method) bool) Methods {
+	return f.FilterMethodsN(fn, -1)
+}
+
+// FilterMethodsN returns a copy of Methods, omitting elements that are rejected by the given function.
 // The n argument determines the maximum number of elements to return (n < 1: all elements).
-func (f *File) FilterMethods(fn func(*Method) bool, n int) Methods {
+func (f *File) FilterMethodsN(fn func(*Method) bool, n int) Methods {
 	cap := n
 	if n < 1 {
 		cap = len(f.Methods)
@@ -14,7 +20,7 @@ func (f *File) FilterMethods(fn func(*Method) bool, n int) Methods {
 	for i := range f.Methods {
 		if fn(f.Methods[i]) {
 			if result = append(result, f.Methods[i]); len(result) >= cap {
-				return result
+				break
 			}
 		}
 	}
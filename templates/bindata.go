@@ -25,7 +25,133 @@ func bindata_read(data []byte, name string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-var _equal_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xc1\x4a\x03\x31\x14\x45\xd7\x13\xc8\x3f\x5c\x77\x53\x90\x19\x70\x29\xb8\x10\x71\xa9\x0b\xf1\x07\x9e\xe1\x85\x86\xa6\x49\x9b\x97\x64\x28\x21\xff\x2e\x9a\x2e\xda\xed\xe5\x9e\xc3\x59\x57\xbc\x9f\x0b\x79\x50\x90\x8d\x93\x60\xdb\x73\xde\x73\x42\x85\x13\xf0\xb9\xb8\x4a\x9e\x43\x46\x8e\x68\x6d\xf9\x32\xf5\x93\x8e\xdc\xfb\xa2\xd5\xba\xe2\xd5\x6f\x74\x11\x24\xce\x25\x05\x81\x25\x2f\x0c\x67\x07\x1c\x62\x06\x5d\xa1\xef\xcb\x69\x40\xb6\x04\x83\xf9\xd6\x74\xf7\xd8\x8d\x9a\xb9\xc2\x85\xcc\xc9\x92\xe1\xd6\x77\xf8\x89\xd1\xa3\x69\x35\xdd\x82\x4f\x8f\x88\x07\x3c\xbf\xa0\x2e\xf3\x9d\x43\xab\xc9\x59\x3c\xc4\xc3\x3f\x32\x8d\xba\x11\xa7\xd5\xd4\x87\xe6\xc3\x89\x59\xde\x8e\x27\xe9\x7f\xc3\xf5\x93\x53\x61\xad\xfa\x6f\x00\x00\x00\xff\xff\x47\x9e\x9c\xf8\x15\x01\x00\x00")
+var _all_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8f\x31\x4b\x04\x31\x10\x85\xeb\xe4\x57\x3c\xbb\xbb\x26\xdb\x0b\x16\xd7\x58\x5a\x88\x9d\x58\xc4\x75\xb2\x1b\xc8\x26\xcb\x64\xf6\x96\x25\xe4\xbf\x4b\xc8\x89\x5e\x21\x84\xc0\xcc\x7b\x7c\x7c\x33\x0c\x28\xc5\xbc\xd8\x85\x6a\x85\x8d\x79\x27\xce\xd8\x67\x92\x99\x18\x32\x13\x26\x7f\xa5\x08\xb7\xc5\x51\x7c\x8a\xb0\xe3\x48\xab\x64\xd8\x10\x40\x81\x16\x8a\x92\x91\x5c\x83\x3c\x87\xaf\xce\x31\x7a\x18\x70\x09\xbb\x3d\x32\x98\x64\xe3\x98\x21\xbc\x11\xfc\x7d\x0f\x3e\x83\x96\x55\x0e\xa3\x1b\x1f\xa7\x52\xcc\xeb\x78\xbd\x85\x7d\x78\x3b\x56\xaa\xf5\xfc\x2b\x79\x72\xdd\xa6\x95\x2f\x3c\xfd\xe4\x9f\x29\x85\xfe\xa3\x68\xe5\x12\xc3\xe3\xf1\x09\x6c\xe3\x44\xf8\xcb\x35\x77\x06\x45\x2b\xe5\x1d\x1e\x5c\x3c\xfd\x5b\x7a\xf7\x1f\xe7\x06\x55\xaa\x1f\x03\x67\x43\x26\xad\x54\xd5\xed\xdd\x96\xc2\x1b\xe9\xfa\x3d\x00\x2a\x8d\xeb\x4c\x4f\x01\x00\x00")
+
+func all_tmpl() ([]byte, error) {
+	return bindata_read(
+		_all_tmpl,
+		"all.tmpl",
+	)
+}
+
+var _any_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8d\x31\x4b\x04\x31\x10\x85\xeb\xe4\x57\xbc\x72\xaf\xc9\xf5\x82\x85\x8d\xa5\x85\xd8\x89\x45\x8c\x93\xdd\x40\x6e\x72\x4c\xb2\x77\x2c\x21\xff\x5d\x62\x56\xdc\x46\x18\x06\x66\xde\xc7\xf7\xce\x67\xd4\x6a\x5e\xec\x85\x5a\x83\xe5\x7c\x27\xc9\xb8\x2f\x54\x16\x12\x94\x85\x30\x87\x1b\x31\xfc\xca\xae\x84\xc4\xb0\xce\xd1\xb5\x64\x58\xde\x40\x91\x2e\xc4\x05\xc9\x77\xc7\x73\xfc\x1a\x1a\xa3\x3b\x8d\xa9\x56\xf3\xea\x6e\xbb\x7a\x1c\x6f\xdb\x95\x5a\x3b\xfd\x55\x4e\x7e\xb8\x3b\xfc\x24\xf3\x6f\xfe\x99\x52\x1c\x1b\x55\x2b\x9f\x04\x01\x0f\x8f\x10\xcb\x33\xe1\xe8\x35\xc7\xe2\xce\xaa\xe0\xe1\x79\xfa\x97\x79\x0f\x1f\xa7\x1f\x4e\x09\x95\x55\x18\x45\x56\xd2\x4a\x35\xdd\x67\xff\x79\x1b\x33\xe9\xf6\x3d\x00\x49\x02\x0a\x71\x1c\x01\x00\x00")
+
+func any_tmpl() ([]byte, error) {
+	return bindata_read(
+		_any_tmpl,
+		"any.tmpl",
+	)
+}
+
+var _avg_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8e\x3f\x6b\xc3\x30\x10\xc5\x67\xe9\x53\xbc\x31\xa2\x45\xf6\x50\x3a\x14\xbc\x76\xec\x50\xba\x95\x0e\xc2\x3d\x35\x07\x96\x1c\xa4\x73\x20\x08\x7d\xf7\x22\x3b\xe9\x9f\x21\x19\xdf\x71\xbf\xf7\x7b\x5d\x87\x52\xec\x8b\x0b\x54\x2b\x12\xc9\x92\x62\x86\xec\x09\x2e\xb1\xec\x03\x09\x8f\x08\xe4\x22\x66\xbf\x9e\x69\xa2\x40\x51\x72\xcb\xa5\xd8\xe7\xe9\x73\x63\xef\x31\x27\xf4\x60\x0f\x16\x70\x06\x85\x83\x9c\xac\xf6\x4b\x1c\xb1\x2b\xc5\xbe\x8e\xc7\xb3\x64\x0b\x6f\xa7\x03\xd5\x6a\x7e\xe5\x3b\x03\x3f\xcd\x4e\x1e\x1f\x50\xb4\x62\x8f\x89\xe2\x3f\xd0\xfe\xd5\x19\x0c\x03\xfa\xf6\xa9\xb6\xd1\xe8\xb5\xaa\x5a\x1d\x5d\x42\x5e\xc2\xa5\x4a\x2b\x3f\x27\x30\x9e\x06\x24\x17\xbf\x08\x57\x0b\xd7\xaa\x46\xde\x0d\x17\xf8\xba\xfd\x9d\x3f\xcc\xaa\x3b\xbb\x1b\xd7\xfd\x60\xb7\x87\x1b\x5d\xbf\x07\x00\x09\x73\x02\xb4\x74\x01\x00\x00")
+
+func avg_tmpl() ([]byte, error) {
+	return bindata_read(
+		_avg_tmpl,
+		"avg.tmpl",
+	)
+}
+
+var _builder_build_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x90\x41\x4b\xc3\x40\x14\x84\xcf\xd9\x5f\x31\x04\x94\x04\xe2\xd6\x73\x41\x0f\x8a\x1e\x04\x45\x8a\x78\x11\x0f\x31\x79\x69\x17\xb7\x1b\xdd\xdd\x04\xca\xe3\xfd\x77\x49\xd2\x86\xd8\xfb\xc7\xcc\x37\xb3\x5a\xe1\xae\x33\xb6\x86\xa7\xd8\x79\x17\x10\x77\x04\x66\xfd\x68\xeb\xb7\xc3\x0f\x89\x14\x68\x3d\x4a\x07\xf2\xbe\xf5\x30\x0d\x4a\x77\x80\xa7\xdf\xce\x78\xaa\xd1\x18\xb2\x35\x76\x65\x80\x6b\x23\xbe\x88\x1c\x02\x45\xad\x9a\xce\x55\xc8\x98\xf5\xa6\xea\x5f\xca\x3d\x89\x0c\xa1\x9b\xaa\x9f\x42\xf3\xa9\x34\xcb\x27\x86\xe2\x7b\x69\xc3\xd0\x35\xb6\xe4\x60\xc5\x7c\x35\x94\xe9\x67\x13\x2a\x7d\xbf\xa3\xea\x3b\x88\xa8\xa4\x2f\x3d\xf6\x26\x04\xe3\xb6\xf8\xf8\x0c\xd1\x1b\xb7\x55\x09\xf3\x39\x67\x1a\x58\x72\xd9\x11\xcd\x71\x8b\x6b\xb0\x4a\x92\x69\x25\x98\xe7\xec\xd7\xe8\x45\x9c\xb1\xcc\x64\x03\x89\x2c\xb7\xb3\x30\x93\xab\x07\xb1\x66\x1f\xf5\xc3\x20\xd7\x64\xe9\x12\x59\xcf\x3a\xff\x3f\x09\x6b\x5c\x84\xb4\xc0\xa4\x18\xf4\x53\x6b\x66\x9d\x02\x69\x81\x34\xcf\x55\x22\xe3\xce\xb1\x42\x25\xe7\x52\x3d\xd6\x37\xc7\xd7\xa6\x0b\x75\xaf\x4e\x03\x2e\xfb\x02\x0b\xe9\x79\xd6\x12\x3e\x11\xae\x16\x51\xf2\x37\x00\x18\x5b\xc0\x3e\xe9\x01\x00\x00")
+
+func builder_build_tmpl() ([]byte, error) {
+	return bindata_read(
+		_builder_build_tmpl,
+		"builder_build.tmpl",
+	)
+}
+
+var _builder_field_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\xcd\xbf\x0a\xc2\x30\x18\x04\xf0\xb9\x79\x8a\xa3\x93\x2e\xe9\x13\x74\xe8\xe2\xd8\x41\xdc\xc4\x21\xd4\xcf\x2a\xb4\x41\xf2\x0f\xe4\xe3\x7b\x77\x29\x09\x36\x38\x1e\xfc\xee\xae\xeb\xc0\xac\x47\xb3\x92\x08\x3c\x05\x8f\xf0\x24\xcc\xaf\x44\x16\xc9\x2c\x91\x60\xfc\x26\x4e\xcb\x3d\x23\xad\x1e\xd1\x4e\x38\x30\xeb\xf3\x94\x4a\x31\x87\xcb\xe7\x4d\x22\xc7\x7d\x70\x43\x83\x9b\x77\x34\xb8\xb9\x42\xbf\x0a\x58\x35\xf5\x9e\x4e\xba\xbe\x44\x5f\xba\x39\xfe\x59\x4f\xe1\xda\xd6\xbc\xbd\xa1\x47\x70\x91\x54\xe3\x28\x44\x67\xcb\xd7\x68\x56\x12\x51\xf2\x1d\x00\x65\xbf\xd9\x25\xf3\x00\x00\x00")
+
+func builder_field_tmpl() ([]byte, error) {
+	return bindata_read(
+		_builder_field_tmpl,
+		"builder_field.tmpl",
+	)
+}
+
+var _builder_new_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\x31\x4b\xc4\x40\x10\x46\xeb\xd9\x5f\x31\x6c\x21\x77\x72\xec\xf5\x57\x8b\x9d\x16\x22\x36\x62\x31\x26\x13\x6f\x71\xb3\x09\xbb\xb3\x09\x32\xcc\x7f\x97\xa0\xa0\x82\xd7\x3e\xf8\xbe\xf7\x8e\x47\x54\x0d\xf7\x34\xb2\x19\x76\x85\x49\xb8\x22\x61\xe6\x75\xe3\x0f\x2c\x4f\x94\xaa\x99\x6a\x1c\x30\xdc\xc5\xda\x85\x1b\x1e\xa8\x25\xa9\x66\xb8\x46\x39\xa3\x9c\x19\xfb\x2f\x86\x0b\xa5\xc6\x55\x95\x73\x6f\x16\xdc\xd0\x72\xf7\x73\xbf\xdb\xe3\xf5\xef\x4f\x54\x07\x85\xa5\x95\x8c\x57\x7f\x5c\x0e\x60\x39\x6d\xbb\xdb\xd4\x3f\x7e\xcc\xbc\xe9\xbd\xfa\x0b\x15\x0e\x00\x54\xff\xa1\xdf\x19\xaa\xde\xbc\xd9\xc1\x01\x54\x96\x13\x8e\xf4\xce\xbb\x91\xe6\xe7\x2a\x25\xe6\xb7\x97\xd7\x69\x4a\xfb\x83\x03\x73\xf6\x39\x00\x8a\x59\x80\xb9\x0d\x01\x00\x00")
+
+func builder_new_tmpl() ([]byte, error) {
+	return bindata_read(
+		_builder_new_tmpl,
+		"builder_new.tmpl",
+	)
+}
+
+var _chunk_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xcf\x6e\x13\x31\x10\x87\xcf\xf6\x53\xfc\x4e\x28\xa1\xe0\xc0\xb5\xed\x22\x21\x24\x6e\x20\x84\x2a\x2e\x55\x0f\xee\xee\x6c\x76\x54\x67\x12\xd9\xde\x48\xc1\xf2\xbb\x23\x7b\x97\xfc\x01\xa9\x39\xec\x61\xc6\x33\xdf\x7c\x33\xbb\x5a\x21\x25\xf3\xdd\x6e\x28\x67\x84\x9d\xe3\x18\x4a\xe2\xab\xeb\xe6\x1c\x4b\xdc\xa2\xdd\x4a\xa0\x76\x8c\xbc\x27\xb4\xc3\x28\x2f\x01\xdb\x1e\x71\x20\xac\x79\x4f\x82\xc0\xbf\xe9\xae\xc6\xce\x86\x38\x95\x60\x63\x0f\x78\x26\x84\x8d\x75\x8e\xbc\xd1\xab\x15\x1e\x86\x63\x7f\x18\xac\xa7\xda\xf2\x6c\xdb\x17\x96\x35\xac\xf7\xf6\x50\xb8\xe7\xf3\x0d\x3e\x57\x3a\xee\xf1\x11\x9e\xc2\xe8\x62\x00\x0b\x2c\x02\xcb\xda\xcd\x38\xa3\xfb\x51\x5a\x2c\x52\x32\x3f\xdb\xfd\x6c\x3e\x05\x0f\x87\x1d\xe5\xbc\x3c\x6d\xb9\xa8\x38\x96\xb8\xc4\xe3\x53\xa9\xa1\xf8\xcb\xba\x50\x1a\xb4\xe2\xfe\x34\x2d\x69\xa5\x6a\xd0\xc0\x91\x5c\xb0\xcd\xb9\xe2\x52\xab\xac\xd5\xde\xfa\xd9\xef\x1f\xac\x56\xfd\xd6\x83\x71\xdb\xe0\xc3\x1d\x18\xf7\x57\x68\xa5\xe6\xa6\x99\x34\x8a\x02\x49\x57\x7a\x19\x37\x35\xa7\x55\x91\x2c\xc9\x4f\x57\x40\x65\x9f\xa9\xfd\xfa\x02\x65\x03\x95\xd2\x7b\x70\x0f\xf3\x8d\x43\x6b\xbe\x0c\x96\x25\x97\x74\xbd\x70\x51\x48\xe9\xf8\xfa\x23\xfa\x9c\xdf\xa6\x44\xd2\xe5\x7c\x0e\xfe\x5b\x7f\x31\x00\x0d\xce\x6b\x2e\xde\x1e\xf9\x96\xa4\x2b\xdf\x93\x56\x6a\xbe\x60\x03\xbb\xdb\x91\x74\x8b\x29\x7e\xf7\xdf\xe8\x37\xf3\xe8\xea\xb6\x9c\xdd\xc9\x05\xca\xf9\x55\xca\x75\x89\x23\xac\x6c\x56\xff\xac\xa7\x38\x7a\x81\xa7\x30\xba\xa8\xf3\x9f\x01\x00\x6e\x55\x05\xb1\x32\x03\x00\x00")
+
+func chunk_tmpl() ([]byte, error) {
+	return bindata_read(
+		_chunk_tmpl,
+		"chunk.tmpl",
+	)
+}
+
+var _clone_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\xc1\x6a\x85\x30\x10\x45\xd7\xc9\x57\xdc\x55\x79\x16\x8c\xd0\x65\xc1\x95\xeb\x96\x52\x4a\xf7\x12\x47\x08\xa4\x49\x30\x5a\x90\x61\xfe\xfd\x11\x75\xa1\xe0\x72\xce\x9c\xb9\x73\x9b\x06\x9d\x8f\x81\x30\xd1\xbc\x4c\x21\xa3\xc7\x40\x94\x60\x63\x5a\x11\x47\x30\x9b\x6f\xfb\xff\xd9\xff\x91\x88\xd1\xe3\x12\x2c\x1e\x67\x76\x08\x3f\x6b\x22\x91\x6a\xcf\x7a\x54\x1b\xa5\xf9\xb7\xf7\xb9\x28\x5a\x31\xd7\x70\x23\xcc\x87\xcb\xd6\x7c\xcd\x93\x88\x56\xee\x9a\x8e\xb6\x45\x70\xbe\xd8\x6a\x2f\x53\x46\xad\x44\xab\xb3\xf6\x86\xf7\x16\xaf\x67\x52\xf6\x35\xc8\x67\x92\x3b\xf7\x46\x0d\xc3\x61\x6e\x75\xba\x98\x1c\xe5\x42\x8e\xb7\xcc\xd7\xaa\x2f\xcc\xdb\xc9\x25\x5a\xcb\x73\x00\x6d\x4c\x7b\x95\x3b\x01\x00\x00")
+
+func clone_tmpl() ([]byte, error) {
+	return bindata_read(
+		_clone_tmpl,
+		"clone.tmpl",
+	)
+}
+
+var _compare_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\xcd\x4a\xc4\x30\x14\x85\xd7\xc9\x53\x9c\xa5\x32\xf6\x77\x29\xcc\x6a\xd6\x8a\x88\x2f\x10\x32\x77\x6c\xa0\x8d\x35\x49\x95\x72\xb9\xef\x2e\xb1\x08\x46\x3b\xcb\x43\xbe\x7b\xce\x47\x9a\x06\xa7\xb7\x69\x36\x81\x10\x28\x2d\xc1\x47\x54\x1d\xdc\x05\xcc\xf5\xb3\xfd\x78\x34\x13\x89\xc0\x45\x8c\x14\x23\xd2\x60\x7c\xf1\xd2\xdf\xe9\xa6\x41\x9b\x0f\xd2\x40\x2b\x72\x0f\xbd\x2f\x66\x84\xf1\x67\x1c\x76\x9b\x5e\x03\x99\x44\x61\xa7\xac\xd6\x97\xc5\x5b\xdc\x14\x17\x5b\x78\x59\x67\x12\xb9\xfd\x91\x2d\x90\xfe\x0f\xe3\x7c\x02\x6b\xe6\x2a\xaf\xd7\x0f\x2e\xda\xfa\x29\x05\x11\xad\xe2\xa7\x4b\x76\x00\x6b\x65\x4d\xa4\x62\x1c\xc7\x63\x91\xfb\x7b\xad\xd4\xf6\x25\x68\xaf\xf0\xde\x8d\xbf\xa8\xaa\xdb\xc1\xfa\xff\xdc\xa1\xd3\x4a\xbe\xf5\xc8\x9f\xb3\x15\xf3\x26\x79\x9a\xe6\x98\x73\xa0\xb4\x04\x8f\x56\xcb\xd7\x00\xc3\xd0\xb9\x47\x9e\x01\x00\x00")
+
+func compare_tmpl() ([]byte, error) {
+	return bindata_read(
+		_compare_tmpl,
+		"compare.tmpl",
+	)
+}
+
+var _contains_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\x3d\x6b\xc3\x30\x10\x86\x67\xe9\x57\xbc\xa3\xbb\xc8\x7b\xc1\x43\x97\x8e\x1d\x4a\xb7\xd2\x41\x75\x4e\xb6\x40\x91\xc2\xe9\xc3\x04\xa1\xff\x1e\x44\x1c\x92\x0c\x81\x1b\xee\xee\x79\x79\xee\xc6\x11\xb5\xaa\x2f\x7d\xa4\xd6\xa0\x7d\xdc\x88\x23\xb6\x95\xd2\x4a\xdc\xc9\xa7\x3b\xec\x70\x0e\x3e\x69\xeb\x23\xd2\x4a\x58\x6c\x21\x8f\xa2\x5d\x26\x25\x4d\xf6\x33\x86\x5a\xd5\xf7\x5c\xf6\xf0\x75\xf8\x39\x9f\xa8\xb5\xb7\xfb\x85\xa1\xf4\xfe\x83\x97\x1b\xf9\x0f\xc1\xa1\x4a\x61\x02\xc3\xe2\x7d\x02\x6b\xbf\x10\x1e\x5d\xea\xe9\x8b\x2a\x85\xb0\xe6\x75\xe0\xd7\xfe\x61\x9a\x50\xba\x54\x08\xa6\x94\xd9\x23\x71\x26\x29\x44\x93\xbd\xf6\x9d\xd1\x2e\x92\x6c\x97\x01\x00\x1a\x6b\x38\xf8\x00\x01\x00\x00")
+
+func contains_tmpl() ([]byte, error) {
+	return bindata_read(
+		_contains_tmpl,
+		"contains.tmpl",
+	)
+}
+
+var _count_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\x31\x6b\xc4\x30\x0c\x85\x67\xfb\x57\xbc\x31\xe1\xc0\xb7\x17\x3a\x74\xe9\xd8\xa1\x74\x2b\x1d\x9c\x9c\x9c\x33\x24\xf2\xa1\x53\x02\x87\xf0\x7f\x2f\xee\xa5\xb4\x1d\x0a\x1a\xde\x43\xef\x93\xde\xf1\x08\xb3\xf0\x12\x17\xaa\x15\x42\xba\x0a\x5f\xa1\x67\x02\xaf\xcb\x40\x82\x92\x40\x33\x2d\xc4\x7a\x6d\xda\x2c\x3c\xcf\xa7\x3d\xae\xe7\xa8\x88\x42\x88\xe3\x48\x17\xa5\x13\x86\xdb\x17\x3b\xe5\x8d\x18\x69\xe5\x51\x73\xe1\xe0\x9b\x42\x67\x16\x5e\xc7\x6d\x67\xef\xe6\xed\x76\xa1\x5a\xfb\x9f\x0a\x5d\xba\x73\x2d\xfc\x24\xd3\xf7\x7e\x28\x65\xee\x91\x59\x61\xde\x6d\x51\xc0\xcd\x78\x97\x8a\x20\xe3\xe1\x11\x12\x79\x22\xfc\xfe\x10\xfe\x54\x35\xef\x5c\x4e\x48\xdc\xfd\x9b\x79\xcf\x1f\x7d\x3b\xef\x1c\x1f\x0e\xde\xb9\xea\xdb\x08\xe9\x2a\x0c\xf6\xf5\x73\x00\x11\xa1\xe2\x2f\x2a\x01\x00\x00")
+
+func count_tmpl() ([]byte, error) {
+	return bindata_read(
+		_count_tmpl,
+		"count.tmpl",
+	)
+}
+
+var _delete_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xce\x4b\x0a\xc2\x30\x10\xc6\xf1\xb5\x39\xc5\x2c\x5b\x28\xe9\x2d\xdc\x88\x2e\xc4\x0b\x84\xe4\xab\x86\xc4\x28\xcd\x03\x86\x90\xbb\x4b\x6d\xf1\xb1\xfc\xe0\xc7\xcc\x7f\x1c\xa9\x56\x79\x52\x77\xb4\x46\x06\x1e\x09\x91\xd2\x0d\x54\x94\xcf\xa0\x98\x1e\x33\x0c\xd9\xb0\xa8\xbd\x37\x1b\xcc\xc1\x60\x7e\xb3\xab\x2d\x08\xe4\xc0\x03\xd9\x89\x54\x60\x29\xa6\x1c\x34\x75\xb5\xca\xb3\x2e\x9b\x5f\xc7\x85\x9f\x68\xad\xff\x3e\xec\x1c\x78\x59\x47\x1b\xb5\x3c\x80\x3f\x40\xec\xd6\x94\xbf\x2b\xf2\x37\x61\x20\x07\xee\x45\x7b\x0d\x00\x41\x95\xb4\x6d\xc0\x00\x00\x00")
+
+func delete_tmpl() ([]byte, error) {
+	return bindata_read(
+		_delete_tmpl,
+		"delete.tmpl",
+	)
+}
+
+var _distinct_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x4d\xab\xdb\x30\x10\x3c\xdb\xbf\x62\x7a\x4b\x20\x51\x7a\x6e\xc9\xa1\x14\x7a\x29\xed\xe1\xf1\xe8\x25\x84\x22\xe4\x75\x2c\x6c\x4b\x46\x92\xdf\xc3\x08\xfd\xf7\xb2\xfe\x7a\x09\xc4\x50\x30\x18\x69\x66\x77\x66\x76\xd1\xe9\x84\x18\xc5\x6f\xd9\x52\x4a\x70\x14\x7a\x67\x3c\x24\x94\xed\x06\xd8\x92\xb1\x1f\x4d\x31\xc3\xef\x3a\x54\xb6\x0f\x28\xfa\xae\xd1\x4a\x06\x02\x35\xd4\x92\x09\x3e\x46\x5d\x42\xfc\xd2\x5e\x89\x9f\x34\xbc\x0e\x1d\xa5\x74\x80\x16\x24\x56\x0a\x4a\xeb\xf0\x5e\x69\x55\x21\x54\x84\x9b\x7e\x23\x83\xb2\x37\x2a\x68\x6b\x56\x65\x86\xbc\x6c\x09\x35\x0d\x31\x92\x29\x52\x12\xf9\xe9\x84\xd7\x8a\x50\x6a\xe7\x03\xac\x52\xbd\x73\x64\x14\xb1\x3f\x92\xaa\x5a\x24\xa0\x3d\x6a\xea\xc2\x01\xda\xc0\xba\x82\x9c\xc8\x59\x00\xbb\x18\xc5\x8b\x7a\x9b\x53\x4c\x87\xc9\xe3\xfe\x23\xfc\xee\x59\x86\x9a\x86\xd1\x23\x77\xf8\xe6\x6e\x77\x45\x8f\xbc\xd9\xea\x88\xbc\x50\xf8\x23\x1b\xcf\x4a\x79\xe6\x89\x0c\xbe\x9c\xd1\xca\x9a\x76\xad\xec\x2e\xcf\x54\x9e\xb5\x6b\xfc\xf8\xff\x50\x9d\x25\xae\x3e\xb8\x5e\x85\x98\x0e\x68\xc8\x3c\x24\x13\xf7\xcb\xda\xef\xf3\xcc\x91\xef\x9b\xb0\xca\x4f\xf0\xb2\x9c\xcf\xff\xd1\x80\x57\xa6\xb9\xde\x49\x73\x23\x6c\x72\x39\x69\xa6\x0b\x66\x6e\x4c\x71\x5b\xe7\xa2\xaf\xfb\xbb\xbc\x9b\xa4\x39\x7f\x9e\x65\xba\xc4\xdf\x03\x6c\xcd\x72\x3c\xdf\x8b\x2e\xae\x5f\xf1\xc9\xd6\xa3\x8d\x6c\xb9\xc2\x19\xcb\xac\x62\x62\x60\x1e\xc7\x19\xb2\xeb\xc8\x14\xbb\xe9\x7c\xd8\x8e\xc5\xd6\xf2\x2c\x4b\x39\x7f\x31\x1e\xb1\x46\xfb\x5e\x49\x6d\xd2\x78\xbb\x51\x8b\x33\xa6\xfe\xbc\x06\x7e\x55\x0f\x32\x5c\x78\xc4\x14\x7a\x25\x2c\xfc\x18\x8f\x20\x53\xa4\x94\xa7\x7f\x03\x00\x4d\x27\xb1\xc2\x9e\x03\x00\x00")
+
+func distinct_tmpl() ([]byte, error) {
+	return bindata_read(
+		_distinct_tmpl,
+		"distinct.tmpl",
+	)
+}
+
+var _elems_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\xd1\x6a\xdb\x30\x14\x7d\x8e\xbf\xe2\xec\x2d\xa1\xae\xd2\xe7\x76\x1e\x94\xc2\x60\xb0\x8d\xd2\xed\xad\xe4\x41\xb1\xaf\x53\x31\x45\x0e\x92\x9c\x2e\x53\xf5\xef\x43\xb2\x9d\xd9\x49\xdc\x66\x50\xe8\x43\x2d\xdf\x7b\xcf\xc9\xb9\x47\xc7\xf3\x39\x6e\x8b\xc2\x39\xf6\x4d\x98\x9c\xfd\x10\x6a\x55\x4b\xae\xbd\x07\xdf\x6c\x48\x15\x06\xf6\x89\xb0\x12\x5b\x52\xd8\x72\x59\x93\x81\xad\xe0\x1c\xfb\x2c\x8b\xef\x7c\x4d\xde\xb3\xa4\xac\x55\x8e\xa9\x73\xec\x21\xdf\x36\x67\xe8\xe6\xdd\x5b\xfd\x73\xb7\x21\xef\x67\xa7\x51\x42\xd7\xad\x5e\xb5\x5d\x8c\xb1\xe6\xb9\xeb\x71\xc9\xa4\x3f\x96\xf5\x71\x91\xb5\x14\xa7\xa3\x25\x29\xfa\xe3\x19\x63\xb3\xc4\x27\xce\x89\x12\x0d\x8f\xbb\x6a\xbd\xe1\x9a\x2f\x25\x79\x9f\xcc\xe7\x78\xa0\x75\xb5\xa5\x63\x96\xd0\xf1\x85\x01\x97\x12\x55\x9e\xd7\x5a\x93\xca\xc9\xa0\x2a\x0f\xe5\x41\xa9\xab\xf5\x40\x9f\x14\x9a\x6a\x23\xd4\x0a\xc2\x1a\x2c\x79\xfe\x2b\xfc\xcf\xb5\xe6\x3b\x16\x40\xbf\x58\x70\x65\x9e\x49\x1b\x3c\x3f\x91\x7d\x22\x0d\xae\x76\x78\x26\x4d\x2d\x6e\x71\xae\xc4\x63\xfc\x87\x2a\x0f\x25\x5e\x56\x95\x84\x4b\x26\x9a\x4c\x2d\x2d\xae\x33\x8c\xca\xf9\x78\x7d\xb5\x48\x26\x65\xa5\x21\x42\x9d\xe6\x6a\x45\xe3\xd5\x61\xe8\x44\x94\xaf\x8c\x13\x0b\x7c\xc8\x06\x2b\x8a\x3d\x1d\x95\xfd\x7e\x9b\xe7\xf4\xd5\x49\xb3\x64\x32\xf1\x49\xf8\x13\x25\x24\xa9\xb6\x69\x86\x2c\x8b\x8f\xa3\xbd\xd1\x64\x13\x4d\xb6\xd6\x0a\x25\x97\x86\xe2\x94\x2d\xd7\xf8\x43\xba\x1a\xa8\xd5\xfb\xf1\x3d\x88\x1b\x08\x7c\x7c\x03\xe4\x06\xe2\xe2\x22\x22\x8d\xd6\x3c\x8a\x05\xb2\x88\x19\x09\x8c\xd6\x21\x43\x03\x9c\x74\xac\xad\xae\x29\x1a\x9b\x54\xd1\x18\xf9\xd8\x02\xb7\x16\x4d\x75\x73\xa1\x49\xd2\x9a\x94\x0d\x06\x1e\xcc\xe6\xb6\x67\x68\xa1\x0a\xfa\x9d\x82\xab\x62\xef\xcc\xf0\x32\x1e\x43\x18\x08\xd5\x78\x60\xcc\x9e\x0f\xf9\xb6\x73\xd9\x29\x42\x53\x01\xa1\xec\x0c\xad\x3b\x9b\xd2\x34\x1a\x32\x2e\x45\x94\x51\xd8\x2b\xbc\xbc\x40\xe0\xd3\x59\x7b\x1c\x59\x5b\xa7\x54\x78\x95\xf6\xb6\xdc\x1e\x8f\x0e\x7d\x14\x8b\xb4\x93\xb7\xaf\xeb\xbd\xac\x35\x97\xde\x7f\x25\x35\x90\x55\xd5\xeb\x25\xe9\xa0\x6a\x2b\xb0\x39\x54\xf8\x3f\xb4\xea\x81\x4c\x67\x41\x2a\xb8\x3d\xe3\xd7\xa5\x68\xd9\xde\x49\xe2\xfa\x70\xda\x20\xcb\xf6\x2c\x4f\xa6\x96\xe5\x42\x75\xb9\x95\xf3\x0d\xcf\x85\xdd\x9d\x1b\x45\x27\xb1\xa7\x71\xaf\x6f\x5d\xad\x73\x72\xe5\x3d\x6e\xd1\xf8\x8c\x90\x73\xe1\x3e\x5d\xe2\xf4\xa7\x22\x58\xe1\xae\x52\x41\x1f\x73\xec\xec\xa3\x38\x1f\xe0\xe6\x6d\xdf\xe1\x87\xe3\x0c\x63\x8c\x43\x9e\x13\xf0\xef\x9a\xdb\xd9\xe9\xdc\xfe\x17\x47\x5d\x1a\xb7\x67\xcd\x95\xf3\x89\x73\x97\x20\x55\x78\xff\x77\x00\x0b\xe1\x5f\xe3\x77\x08\x00\x00")
+
+func elems_tmpl() ([]byte, error) {
+	return bindata_read(
+		_elems_tmpl,
+		"elems.tmpl",
+	)
+}
+
+var _equal_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\x41\x4e\xc3\x30\x10\x45\xd7\xf1\x29\x3e\xbb\x22\xd1\x44\xea\x12\x29\x0b\x84\x58\x82\x10\xe2\x02\x26\x1d\xab\x56\x5d\xbb\xb5\x9d\x44\x95\x3b\x77\x47\xa9\x0b\xc4\x5d\x00\xbb\x68\xf2\xff\xbc\x37\x72\x4a\x5a\xa1\x7e\xd6\xa1\xab\xdf\x8f\x7b\x5a\x63\xc9\x2c\x9a\x06\x4f\x87\x5e\x1a\x48\x1b\x46\xf2\x01\xe3\x86\xe2\x86\x3c\x52\xaa\xdf\xba\xe1\x45\xee\x88\x79\x05\x1d\x40\x87\x5e\x0f\xd2\x90\x8d\x88\xae\xf8\x5d\x0b\xd5\xdb\x0e\x8b\xf9\xec\x12\x98\x40\xcc\xb7\x99\x51\x04\x56\x57\x89\x0f\xe7\x0c\x92\x48\x69\x09\x32\x81\x7e\x97\x1b\xfe\x12\x6a\x1a\x3c\x98\x51\x1e\x03\x3c\xc5\xde\xdb\x00\x25\xa7\xad\x5a\xe5\xae\x75\x11\xb2\x30\xf8\xf7\x11\x03\xb4\x8d\xe4\x95\xec\x28\xfd\x78\x57\xf3\xde\xea\x0e\x6e\x8b\xfb\x16\x43\xbd\x28\x56\x88\x4a\x2b\xdc\xb8\x2d\x92\xa8\xaa\x6c\x96\xc5\x44\xc5\xf9\x74\xbb\xe6\xfc\xf5\xfd\x56\xaf\xd1\x33\x9f\x8b\x85\x5a\xdb\xc2\x6a\x83\xd3\x09\x05\xf9\x6b\x3e\x03\x5c\xd7\x8a\x7c\x09\x9e\xae\x38\x43\x1f\x77\xfb\x30\x51\x2f\x2b\xa2\xef\x49\xf0\xe7\x00\x04\xa6\xed\x23\x41\x02\x00\x00")
 
 func equal_tmpl() ([]byte, error) {
 	return bindata_read(
@@ -43,6 +169,51 @@ func filter_tmpl() ([]byte, error) {
 	)
 }
 
+var _filter_err_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\x5f\x6b\xeb\x36\x14\x7f\x96\x3e\xc5\x59\xa1\x2c\x06\xd5\x59\x5f\xdb\x65\x30\xca\x0a\x1b\x2c\x0f\xa3\xec\x61\xa5\x0f\x8a\x7d\x9c\xa8\xb1\xa5\x20\x29\x6d\x8a\xd1\x77\x1f\x47\x72\x62\xbb\x75\xca\xbd\x70\x2f\xdc\xa7\x56\xd6\xd1\xf9\xfd\x39\x3f\x29\xf3\x39\xb4\x6d\xbe\x94\x0d\x86\x00\x16\xfd\xde\x6a\x07\x12\x0a\xb3\x7b\x03\x53\xd1\xde\x7d\x5d\xa6\x6d\x01\xa6\x51\xde\x2b\xbd\x06\xac\xb1\x41\xed\x1d\xf8\x8d\xf4\x20\x2d\x82\xc5\x67\x2c\x3c\x96\xb0\x7a\x03\xbf\x41\x58\xab\x17\xd4\x50\xed\x75\xe1\x95\xd1\x39\x6f\xdb\x2b\x50\x15\xe4\x7f\x2b\x57\xe4\x7f\x19\xa5\x43\xe0\xf3\x39\xfc\xe9\x7b\xd0\xba\x06\xb4\xd6\x58\x27\x40\xd6\x46\xaf\xe1\x55\xf9\x4d\x6c\xa6\x74\x89\x07\x74\x44\x88\x96\x47\x74\x01\xcf\x46\x69\x2c\x53\x77\xac\x1d\xf6\x10\x7f\x58\x7b\x42\x70\xde\xec\x1c\x48\x1f\x7b\x55\xca\x3a\x9f\x80\x04\xbc\x6e\x54\xb1\x01\xe5\x3a\x12\x58\x4e\x22\xbf\xc3\xed\xe0\x74\x19\xc2\x58\xd6\x9d\x3f\xbc\xc3\x54\xd5\xc0\x8c\xc2\x68\x8f\x07\x4f\x70\xa5\xd1\x28\x40\xe9\x8e\x40\x21\x1d\xc6\xc2\xae\xe4\x67\x97\x08\x0e\x99\x0d\x51\xc9\x56\x98\xb5\x6d\xfe\x4f\xf1\xd2\x8d\x2e\x2d\x1e\xde\x76\x18\x42\xd6\x8f\x74\xd6\xb6\x63\x7a\x85\x3f\x1c\x89\xe4\x77\xe9\xaf\x80\xb6\x8d\x7d\xab\x34\xb0\x8f\x67\xce\xd4\xb7\x6d\xfe\xbb\x5d\xf7\x98\xa7\x53\xd1\xfc\xd9\xca\x98\x5a\x24\x21\x59\xdb\xd2\x78\x42\xa0\x6f\xdd\xe9\x2c\x29\x40\xff\xaf\xac\x1d\xa5\x2b\x55\x42\xcb\x59\xd2\x0c\x43\x81\xf9\x49\xd2\x72\x4a\xd3\x40\x83\x80\xab\xeb\x8c\x07\xce\x87\xd1\x5e\x7e\xcf\x6c\xcf\xe7\xf0\xb0\x41\xd0\x20\xed\x7a\x4f\xf7\x02\x4a\xf4\x68\x1b\xa5\x91\x6e\x08\x42\x23\x0f\xaa\xd9\x37\xa0\xf7\xcd\x0a\x2d\xc5\xa9\x07\x31\x1d\x33\x98\x69\xf8\x15\xae\x6f\xd2\x3d\xe8\xb6\xb3\xfc\x2b\x66\xbd\xfc\x81\x87\x2d\x40\x83\xd2\xfe\x93\x99\x17\x72\x07\x37\x0b\xd0\x9c\xa9\x0a\xa2\x15\x94\x84\xf8\x79\x01\x35\xea\x91\x05\xf9\x70\x76\x19\x67\x81\x32\xe3\xf6\xb5\xa7\x16\x8d\xdc\x22\x55\xdf\xd7\x65\xa2\x2b\xe0\x17\x01\x85\xdc\x65\x9c\x4d\x3d\x43\xec\x45\x5a\x22\xe2\xe0\xf1\x29\x66\x30\x95\xc5\x38\x71\x56\xd1\x35\xa4\xb6\x56\xea\x35\xc2\x59\x16\x91\xed\xc4\x73\xc0\x48\x0f\x5a\x4b\x2d\x0a\x7f\x20\xbb\x66\xd9\x2d\xe1\xc1\x4f\x0b\xd0\xaa\x8e\x07\x07\x91\x8f\x67\xff\x43\x6b\x3a\x7f\x38\x23\x79\x43\x4a\x63\x15\xf1\xb2\x71\xc6\xcc\x56\x1c\x71\x2a\x3d\x15\x85\xe1\x28\xa7\x35\x3c\xaa\xa7\xac\x27\x3c\xa4\x37\xe9\x1b\x63\xd1\xb5\x05\xc8\xdd\x0e\x75\x39\xa3\x95\x80\xaa\xf1\x24\xd2\xd8\x6a\x76\x31\x6a\x7e\x59\x3e\xdd\xc0\xe5\xeb\x85\x00\x15\x99\x66\x84\xc5\x28\x6f\x4a\xef\xf1\x88\x92\xde\x89\x4f\x2c\xf9\xf2\xfe\x9c\x8d\x6d\x0b\xfc\x1d\x84\xd9\x7e\x03\xb7\x86\x08\xaa\x02\xb3\x4d\x8e\xa9\x0a\xba\x48\x9e\xfc\x49\x6b\x71\x3e\x43\xd4\xf1\x36\x86\x3d\x95\x66\xf0\xdb\x82\x82\x9b\x3a\xb2\x95\x45\xb9\xa5\xff\x3a\x31\xe1\x4c\x9e\xfb\xc0\xc5\x38\xbb\xb8\x11\xa7\x93\xe7\xf9\xc7\xec\x7d\x16\xbd\xc0\x87\xfa\x46\x68\x77\x1b\x19\x7f\xc5\xd9\x59\x39\xb0\xe8\x2c\x98\x7c\xd1\x05\xa5\x8b\x8f\x06\xd2\x55\x1d\x7d\xea\xf7\x75\x19\x02\x0f\xff\x0f\x00\xa8\x0f\x26\x57\xac\x08\x00\x00")
+
+func filter_err_tmpl() ([]byte, error) {
+	return bindata_read(
+		_filter_err_tmpl,
+		"filter_err.tmpl",
+	)
+}
+
+var _filter_inplace_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x93\x41\x8f\x9b\x30\x10\x85\xcf\xf8\x57\xbc\x23\x68\x53\xe8\x5e\x37\xcb\xa1\xaa\xd4\x5b\x73\x58\xad\x7a\x59\xe5\x30\xc0\x40\xdc\x98\x21\x32\x26\x6a\x8a\xfc\xdf\x2b\x03\xcd\x22\xb5\x89\xd4\x4b\x0f\x91\xec\xf8\x9b\x37\x6f\x9e\x4d\x96\x61\x1c\xd3\x1d\xb5\xec\x3d\x2c\xb7\xdd\x99\x7b\xb8\x03\x83\x0d\xb7\x2c\xae\x47\x57\x07\xe2\x8b\xa9\x16\xc8\x1d\xc8\x81\x2c\xc3\xf2\x77\x2e\x1d\x57\x28\x2e\x53\x45\xa3\xcf\x2c\xa8\x07\x29\x9d\xee\x64\x03\xcb\x43\xaf\xa5\x81\x76\x3d\x0a\x2a\x8f\x61\x4d\xd6\xd2\x25\x55\x01\x42\x3c\x8e\xe9\x4b\x79\x5e\x64\xe7\xcd\xeb\xe5\xc4\xde\x27\xef\x9e\xe2\x7a\x96\x0c\xf0\x27\xdb\xfc\x3e\x2f\xba\xce\x4c\xd4\x0b\xbb\x6f\x64\xfa\x20\xa0\x22\xcb\x6e\xb0\x82\xb5\x6e\x7a\x55\xda\xc5\xb5\x6c\xf0\xe1\x31\x51\x5e\xa9\xf5\xd8\xbb\xff\x37\x77\x96\xe1\xf5\xc0\x10\x90\x6d\x86\xd0\x06\x15\x3b\xb6\xad\x96\xa5\x7b\x4b\x3f\x74\x3b\xb4\x90\xa1\x2d\xd8\x86\xec\xaf\x7e\x5c\x87\x23\xf3\x09\xb1\xe0\x19\x8f\x4f\x20\x63\xae\x87\xc9\xbf\x44\xba\xbb\x93\xe9\x06\x02\x2d\xee\x6f\xd1\xf6\x83\x71\x78\xca\xff\x48\xf7\x9a\xd0\xdb\xd3\xc7\xbd\x8a\xea\xce\x42\x07\xce\x92\x34\x7c\x9b\x0e\xa2\x91\xae\x51\x4b\x7c\x93\x79\xd3\xfb\x64\xe2\x02\xb8\x38\xc8\x41\xa7\x13\x4b\x15\xcf\xfb\xcd\x1d\x3f\x7a\x9f\x6c\x61\x58\x16\x34\x41\x9e\x43\x66\xbd\xa8\xb0\x4c\xc7\xb0\xf2\x6a\xfa\x79\x15\x65\x19\x7e\xb2\xed\xa6\x7b\x70\xa4\x0d\xfa\xb0\x26\x37\xfd\x31\x3f\x91\xea\xfd\x3a\x4a\x12\x14\x8c\x86\x6c\x41\x0d\xa3\xec\x8c\x99\x5e\x85\x8a\xce\x64\x67\xa1\x75\xbc\xab\x60\x56\x8e\xb6\xd0\x78\x9e\x2c\xde\x9c\x22\xd9\x42\x3f\x3c\x4c\xae\xef\x4d\x8a\x7c\xea\xa9\xc2\x2c\x37\x39\xe4\x4b\x8a\xab\x4f\x45\xd7\x48\xbf\xea\xbe\x4c\x3f\x1f\x48\x8b\xf7\xeb\xe2\x71\x64\xd3\xb3\xf7\x73\xd1\x38\xb2\x54\xde\x2b\xff\x6b\x00\x2c\x07\x55\x16\x34\x04\x00\x00")
+
+func filter_inplace_tmpl() ([]byte, error) {
+	return bindata_read(
+		_filter_inplace_tmpl,
+		"filter_inplace.tmpl",
+	)
+}
+
+var _filter_map_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8f\x31\x6f\xc3\x20\x14\x84\x67\xf3\x2b\x6e\x4c\x24\xcb\xd9\x2b\x65\xe8\x92\xa5\x6a\x07\xb7\xea\x52\x75\x20\xf6\xc3\xa1\xc6\x10\xc1\xb3\x25\x64\xf1\xdf\x2b\x82\xa3\x44\x62\x00\xee\xbe\x7b\xf7\x0e\x07\xac\x6b\xf3\x21\x27\x4a\x09\x9e\x78\xf6\x36\x40\xa2\x73\xd7\x08\xa7\xb2\x76\x32\x7d\x91\x6b\xb8\x49\x33\x6b\x3b\x80\x2c\x7b\x4d\x01\x7c\x91\x0c\xe9\x09\x9e\xfe\xa8\x63\xea\x71\x8e\xe0\x0b\x61\xd0\x0b\x59\xa8\xd9\x76\xac\x9d\x6d\x44\xbe\x61\xb7\xae\x4d\xdb\x2d\xdb\xb0\xf2\xf8\x8a\x57\x4a\x69\xff\x28\xb1\x53\x85\xcb\xe6\x77\x1d\xba\xe6\x8d\x62\x31\xd5\xd9\xf4\xea\x87\x3b\x72\x76\xce\xdc\xc0\x96\xf8\x5b\x9a\x90\x33\x45\xe5\x29\xcc\x86\xf1\x72\xc4\x24\x47\xca\x29\x27\xd3\x6f\x88\xa8\x94\xf3\x18\x29\xd6\x58\xa4\x99\x29\xbb\xbc\xb4\x03\xe1\xb9\x59\xf3\xbc\x74\x8e\xac\xb4\x82\xb2\xbb\x07\xb7\xbf\xfd\x6e\xa3\x7e\x46\x8a\xbf\x38\x16\x45\x54\x55\x12\xf9\xdc\xdb\xb7\xc4\x9f\x3c\x71\x4a\x22\xfd\x0f\x00\xc3\x7c\x84\x5d\x6b\x01\x00\x00")
+
+func filter_map_tmpl() ([]byte, error) {
+	return bindata_read(
+		_filter_map_tmpl,
+		"filter_map.tmpl",
+	)
+}
+
+var _filter_parallel_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x51\x6f\xdb\x36\x10\x7e\xa6\x7e\xc5\xad\x40\x31\x09\x55\x98\xec\x35\x89\x1f\xb2\x2c\x2d\x36\xa0\xeb\x90\x15\xcb\xb0\xc0\x0f\x0c\x75\x92\x59\xd3\xa4\x41\xd2\xb1\x0d\x81\xff\x7d\x20\x29\xc9\xb2\x63\x03\x49\xd1\xa7\x84\x47\xf2\xbe\xef\xbb\xfb\x78\xf2\xf9\x39\xb4\x2d\xfd\x93\x2d\xd0\x7b\x30\xe8\x56\x46\x59\x60\xc0\xf5\x72\x0b\xba\x0e\x7b\x1f\x65\x95\xb6\x4b\xd0\x0b\xe1\x9c\x50\x0d\xa0\xc4\x05\x2a\x67\xc1\xcd\x98\x03\x66\x10\x0c\x7e\x43\xee\xb0\x82\xa7\x2d\xb8\x19\x42\x23\x9e\x51\x41\xbd\x52\xdc\x09\xad\x68\x76\x7e\x0e\x5f\x67\x38\x04\x40\x58\xe0\x4c\xca\x74\xa1\x6d\x45\x0d\xf4\xb3\xb0\x9c\x3e\x68\x33\x47\x63\xbd\x6f\xdb\x17\x01\x94\x16\xbd\xff\xf4\xe5\xf3\xcd\xbf\x7f\xdd\x7f\xb9\xfd\xbb\x6d\x51\x55\xde\x43\xa3\x8d\x5e\x39\xa1\xd0\x02\xd7\x8a\xaf\x8c\x41\xe5\xe4\xf6\x2a\x12\x31\x68\x57\xd2\x05\x69\x4c\xa8\x40\x18\x41\x9b\x0a\x4d\x50\x17\x16\xbd\x14\x9a\xb5\xed\x19\x0c\x3c\xfe\xd0\x42\x79\x1f\x68\xff\xee\x76\x75\x91\x12\xd0\x18\x6d\x6c\x09\x4c\x6a\xd5\xc0\x5a\xb8\x59\xcc\x29\x54\x85\x1b\xb4\x87\x59\x4b\xf8\xa6\x85\xc2\x2a\x65\x0f\x02\x76\x10\x77\xc6\x0c\x08\xd6\xe9\xa5\x05\xe6\x62\xae\x5a\x18\xeb\x12\x50\x09\xeb\x99\xe0\xb3\x50\xae\x44\x02\xab\xa3\xc8\x07\xb8\x1d\x5c\xa8\xce\xbe\xac\x5b\xb7\x39\xc0\x14\xf5\xa8\x5f\x5c\x2b\x87\x1b\x17\xe0\x2a\xad\xb0\x04\xa1\x3a\x02\x9c\x59\x8c\x07\xbb\x23\x3f\xdb\x44\x70\xcc\x6c\x8c\x1a\x1a\x0d\x79\xdb\xd2\x7b\xfe\xdc\xb9\x2b\x2d\xbe\x6e\x97\xe8\x7d\xb1\x73\x5d\x3e\xea\x7e\xa4\xc7\xdd\xa6\x27\x42\x6f\xd3\xdf\x12\xba\x5e\xd7\xc9\x53\x2f\xef\x9c\x38\xdf\xb6\xf4\xc6\x34\x3b\xcc\xe1\x56\x2c\x7e\xfe\xa4\xb5\x2c\x93\x90\xa2\xf7\x57\x88\x75\xb7\xf7\x6e\x7c\x64\x52\x8a\x27\x99\x18\xd3\x7b\x74\xff\x30\x69\xbd\x3f\xbc\x3e\xde\xeb\xd2\x40\x9b\x91\xfd\x2e\x30\xc5\x51\x7a\x9f\x11\xee\x36\x25\xf0\xb8\x84\xcb\xc9\x20\xfb\x41\xb8\xd9\x6d\x8c\x1e\xab\x4e\x0f\xd5\x9f\xfe\x95\xf1\x79\x63\xf4\x4a\x55\x79\xd1\x33\xcf\x48\x85\x35\x9a\x2e\x77\x5e\x24\x06\x91\x4e\x46\xe6\x88\xcb\x00\xb7\x60\x73\xcc\x1f\xa7\x41\x71\x09\x12\xd5\x5e\xbf\xe8\xf8\xed\x17\xc5\x81\x84\x58\xbf\x8c\xa0\x31\x76\x94\xa9\xf3\xec\xab\x52\x75\x5c\x54\xf0\x5b\x9f\x81\xcf\x98\x02\xa1\x5c\x91\x91\x67\x66\x60\xdd\x80\xdd\x2a\x4e\x1f\x98\x70\x9f\x8c\x5e\x2d\x33\x52\x6b\x03\xeb\x38\x23\x02\xec\xc5\x55\xbf\xb8\x7e\xdb\x10\x31\x2b\xe5\xc4\x02\xe9\x6e\x98\xe4\x17\x7d\xed\xfa\x9c\x1f\x3e\x84\xc6\x91\x75\x43\x6f\xaa\x2a\xff\xa5\xc8\x08\x69\x74\xb2\x5f\x11\x77\xba\x12\xaf\x1b\xfa\x9b\x56\x18\x6a\x4c\x22\x3f\x11\xa8\x19\xa6\x1a\x84\xa8\x2e\x9e\x25\xc7\xc6\x4b\x88\x87\x66\x3c\x8a\x69\xf4\x91\x7d\x14\x53\x98\x40\xad\x8e\xb5\x7d\x6c\xea\xe3\xa5\x7d\x14\xd3\x62\xc0\x3a\x36\x6c\xc2\x9e\xa8\xe1\x07\x43\x5e\x0d\x79\x7e\x9a\x80\x12\x32\x15\x87\x90\x9d\xf7\x08\x21\x7e\x8f\xd8\xbe\xf6\x1f\x26\x39\x1c\xcd\x3a\x30\x1f\x80\xfd\xa9\x97\x57\x23\x56\x97\xd9\x61\xbb\x4e\xc2\x44\x49\x16\x25\x72\x17\xff\x8d\xe3\x30\x36\xf7\xfa\x0c\xc4\x65\x1f\xb9\x3e\xe3\x6e\xd3\xb9\x21\x04\xc9\x93\x41\x36\x87\x00\x96\x45\x56\x3e\xdb\x2b\xc1\x9b\xe0\x07\xb8\x5d\x9a\xa4\x97\x4b\x6d\x31\x0f\xdb\x45\x16\xec\x1a\x9e\x4b\x5e\x1c\x28\xef\x1d\x77\x00\x19\x3a\x17\xb3\x8b\xfa\x68\x17\xfb\xd8\x04\xea\x85\xa3\x77\xe1\x7d\xd7\xf9\xbb\x31\xb7\xc7\xf7\xd5\xf4\x12\xde\xaf\xdf\x95\x20\x06\x47\x15\x83\xdc\x94\x37\xbc\x88\x38\x1c\x6c\x24\x92\xa3\x31\x96\x52\x9a\xac\x33\x06\x4c\xdf\x12\xe8\x1f\xee\x7f\x68\x74\x37\x63\xf7\x8a\xf7\xc2\xd8\x6f\x94\x75\x02\xe6\x3b\x35\x8e\x7a\x71\xdc\x6d\xa3\x2a\x04\x83\xdc\x19\x93\x7f\x9f\xf4\xd4\xf0\xee\x37\x4d\x3f\x35\x13\xd3\xf4\x91\x2b\xe1\xe2\x15\xf3\xf7\x4d\xbe\xdb\x0d\x8c\xbe\x76\x11\x7d\x02\x6c\xb9\x44\x55\xe5\x69\x5d\x9e\xce\xf2\xa2\x56\xbb\x02\xcd\x58\x72\xe5\x69\x06\x13\x48\xf9\xb3\x51\x7d\x86\xa3\x47\x3f\xcf\x65\x98\x41\xdd\xdc\xd8\x7f\x6e\x5d\x86\x94\xf0\xb5\x77\x55\xe5\x7d\xe6\xff\x1f\x00\x21\x6a\xf4\xa4\x28\x0b\x00\x00")
+
+func filter_parallel_tmpl() ([]byte, error) {
+	return bindata_read(
+		_filter_parallel_tmpl,
+		"filter_parallel.tmpl",
+	)
+}
+
+var _find_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\xb1\x6a\xc3\x30\x10\x86\x67\xe9\x29\xfe\x31\x06\x63\xef\x85\x0e\x5d\x3a\x76\x28\xdd\x42\x06\xc5\x3e\xd9\x02\x47\x0a\xa7\xb3\x43\x2a\xf4\xee\x45\xb1\x43\x93\x21\xa0\x45\x77\xdf\x7d\xfa\x75\x6d\x8b\x94\x9a\x2f\x73\xa2\x9c\xc1\x24\x33\xfb\x08\x19\x09\xd6\x71\x14\xd0\x44\x27\xf2\x82\x60\x0b\xf6\x39\xf5\x1b\x29\xa3\x11\xb8\x08\xd3\x75\x74\x16\xea\x71\xbc\xde\xa6\x06\xb7\x90\x87\x9d\x7d\x27\x2e\x78\xdd\xb6\x30\xbe\x87\xf1\xf1\x42\x1c\x71\x19\x49\x46\xe2\x42\x32\x95\xf1\xe0\xa9\xd1\x85\xc6\x2e\xa5\xe6\xbb\x5b\x36\xfd\x7a\xf9\xb9\x9e\x29\xe7\xea\x3f\xe0\xce\xae\xee\x02\x7f\xf0\x70\xef\x1f\x43\x98\x2a\x3c\x15\xeb\xad\x98\xb4\xb2\x81\xe1\xf0\xf6\x0e\x36\x7e\x20\x3c\xbe\xd3\x3c\xfd\x29\x69\xa5\x9c\x85\xf5\xbb\x97\xcc\xde\x1d\xaa\x1b\xa7\xd6\x55\xbd\xb6\xed\xdd\xa1\x86\xf0\x4c\x5a\xa9\xac\xcb\x59\x0c\xe3\x97\x38\xe0\x31\xa7\xbe\x8b\x4a\xa7\x86\x35\x53\x24\x9d\xff\x06\x00\x55\xe2\x8b\x34\x95\x01\x00\x00")
+
+func find_tmpl() ([]byte, error) {
+	return bindata_read(
+		_find_tmpl,
+		"find.tmpl",
+	)
+}
+
 var _getter_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd2\xd7\x57\xa8\xae\xd6\xf3\x4b\xcc\x4d\xad\xad\x55\x28\x4a\x2d\x29\x2d\xca\x2b\x56\x28\xc9\x48\x55\x28\x4b\xcc\x29\x4d\x55\xc8\x4f\x03\x49\xbb\xe5\xa4\x40\x54\xe8\xf1\x72\xa5\x95\xe6\x25\x2b\x68\x54\x57\xeb\x05\x25\x97\x41\xb5\x41\x38\x21\x95\x05\xa9\xb5\xb5\x9a\x08\xe3\x34\xc0\xec\xa0\xd4\x92\xb0\xc4\x9c\x62\x90\x32\x5e\x2e\x4e\x88\x0d\x0a\xc8\xda\xf5\x90\x2d\xe0\xe5\xaa\x05\x04\x00\x00\xff\xff\xb6\x49\x5a\xdd\x92\x00\x00\x00")
 
 func getter_tmpl() ([]byte, error) {
@@ -52,6 +223,87 @@ func getter_tmpl() ([]byte, error) {
 	)
 }
 
+var _groupby_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\x31\x6b\xc3\x30\x14\x84\x67\xeb\x57\xbc\xd1\x86\x22\xef\x85\x0c\x5d\xba\x94\x76\x08\xa1\x4b\xc8\xa0\xda\x67\x47\x58\x96\xcc\x93\x1c\x30\x42\xff\xbd\xa8\x76\x5b\xb7\x90\x51\x77\xa7\xfb\x8e\x57\xd7\x14\xa3\x7c\x53\x23\x52\xa2\x9e\xdd\x3c\x79\x0a\x57\x10\x0c\x46\xd8\xe0\xc9\x75\x39\xf0\x6c\xda\x2d\xf3\xb1\x7c\xf9\x03\x16\x4f\x8c\x30\xb3\x45\xfb\x2d\xf6\xfa\x06\x4b\xdd\x6c\x9b\xa0\x9d\x95\xa2\xae\xe9\xf4\xaf\x0b\xaa\xb9\xae\x1c\x52\x0c\xd2\x36\x7f\xd4\x4c\x8e\x75\xaf\xad\x32\xe4\xb8\x05\x4b\x91\x4b\xa8\x8c\x51\x1e\x9b\xdb\x46\x5e\x1f\xa7\x65\x42\x4a\xd5\xef\xea\xb2\x5b\x91\x39\xfc\xc4\xfd\xce\x7f\xd5\xbe\x91\x2f\x58\x76\xd2\x11\xe1\x5d\x19\x9f\xdb\x44\xc1\xf0\xb3\x09\xf4\x78\xa0\x51\x0d\x28\xf7\x76\x25\x8a\xce\x31\xe9\x6c\xb2\xb2\x3d\x68\x3f\x45\xfe\xb9\x48\x14\x45\x31\xe4\x60\x67\xcb\xbb\xa9\xb3\xbe\x54\xa2\xd8\x90\xe7\xe1\x42\x07\x52\xd3\x04\xdb\x96\x3f\xd2\xc3\x7d\xc6\xfa\x3b\xe5\xc9\xf9\xe2\xc4\xf0\xb3\x09\x22\x7d\x0e\x00\xd5\x5d\xce\x80\xbe\x01\x00\x00")
+
+func groupby_tmpl() ([]byte, error) {
+	return bindata_read(
+		_groupby_tmpl,
+		"groupby.tmpl",
+	)
+}
+
+var _hash_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8f\x41\x4b\xc4\x30\x14\x84\xcf\xcd\xaf\x98\x63\x0b\x9a\x7a\x28\x3d\x08\x3d\x0a\x5e\x5c\x44\x05\xcf\xa1\xfb\x42\x1e\x64\x53\xb7\x49\xba\x48\x78\xff\x5d\xb2\x15\xc4\x3d\x4e\x32\xdf\x97\x49\xdf\xe3\xd9\x44\x87\x95\x52\x5e\x43\x84\x81\xab\x71\xb1\x48\x8e\x60\x99\xfc\x31\x62\xb1\x28\x45\xbf\xcd\xdb\xc1\x9c\x48\x04\xc9\x99\x04\x8e\x98\x97\x10\x39\x26\x0a\x09\x17\x4e\x0e\x4f\xe7\x6c\xfc\x9d\xea\x7b\xb0\x26\x0d\x3a\x67\xde\x8c\xaf\xd7\x9b\xf1\x99\x22\x9c\xd9\xa8\x1e\x1b\x7f\x7d\x86\xa2\x56\x36\x87\x19\xed\x3f\xfd\x1e\x3e\xbe\xbf\x48\xa4\xbb\xce\x6b\x3b\x64\x0e\x69\x1c\x50\x54\x53\xca\x3d\xd8\x42\xbf\x70\x9c\xf5\x6b\x5a\x45\x54\xc3\x37\x0b\xa7\x09\x81\x7d\x6d\x37\xfb\xcf\xf0\xa0\x1a\xd9\x59\x0a\xc7\x8a\x94\xb2\x1b\xaa\x9f\x56\x11\x3c\x4e\xb0\x61\xd3\x07\xba\x8c\x83\x69\xbb\xbf\xc6\xe7\xca\x89\x62\x65\x7e\x5d\xb7\xa8\x7e\xcf\xa7\x71\x68\x3b\x25\x3f\x03\x00\x98\x4c\x5d\xd8\x4f\x01\x00\x00")
+
+func hash_tmpl() ([]byte, error) {
+	return bindata_read(
+		_hash_tmpl,
+		"hash.tmpl",
+	)
+}
+
+var _heap_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x94\x41\x6f\x9b\x40\x10\x85\xcf\xe6\x57\xbc\x23\x48\x0e\x69\xaf\x91\x7c\xc8\xc5\x92\xa5\xb6\xb2\xa2\xf6\x14\xf9\xb0\x85\xb1\x19\x05\x76\x57\xbb\x03\x71\x8a\xf8\xef\xd5\x02\x76\x70\x15\x52\x27\x57\x76\xe6\xed\x7c\x6f\xde\x72\x7b\x8b\x6d\xed\x0b\x70\x65\x4b\xaa\x48\x8b\x47\x41\xca\xa6\x1b\x2d\xe4\xf6\x2a\xa3\x14\xbf\x3c\xf5\x35\x1b\xa1\x0a\xac\xbd\x90\xca\xd3\x68\x5f\xeb\x0c\x71\xdb\xa6\x0f\x59\xf3\x43\x55\xd4\x75\x68\xdb\xf4\x3b\xfb\x2c\xdd\x8a\xfb\xf9\x62\xa9\xeb\x92\xbe\x2f\x3e\x82\x4f\x6a\x6d\x97\xa0\x8d\x16\xd3\xb6\xb4\x6d\xd3\x75\x99\x8f\x1a\x2b\x28\x6b\x49\xe7\xf1\x6c\xc9\x12\xc7\x34\x9c\xde\xbb\xc3\x78\x4b\x12\x75\x51\x14\x40\x8c\xfd\x2f\x87\xb1\x9f\xc2\x30\x36\x4e\xa6\x14\x01\x42\xe3\x6e\x85\x92\xf4\xfc\xa8\x09\x6e\xf0\x35\x5a\x34\xa1\x70\xb6\xe8\x51\xef\xa2\x45\xa3\x1c\xfe\x90\x33\x98\x82\xbd\xe3\xd3\xa3\xde\x61\xd5\x77\xbc\x6f\xe6\xbc\xc0\x5d\xb8\xd6\x91\xd4\x4e\xa3\x19\x0d\xdc\x68\x16\x90\x17\xf5\xbb\x64\x5f\x90\x87\x14\xd4\xc7\x01\xac\x1b\xe5\x58\x69\x49\x71\x0f\x6f\x9c\x50\x8e\xcc\x94\x25\x65\xc2\x46\x83\x3d\x14\x1a\x55\x72\x3e\x94\x2b\x8f\x67\x2a\xcb\x6b\xed\x0d\xf7\xc6\x7d\x30\xc6\x9d\xb1\x5c\xf4\x9c\x17\x7c\x4a\xa1\xad\xfb\xf1\x1a\x18\x2d\xe6\x3c\xe5\xd5\xdb\x1c\x65\xe2\xe6\xc2\xee\xd7\x01\xfa\xd4\x4e\x55\x96\x68\x26\x21\xeb\x13\xe4\xa8\x32\x0d\x79\x28\x9d\x63\xb0\x71\xb0\xab\x62\xcd\x55\x5d\x81\x86\x18\x22\x56\x59\x66\x5c\xce\xfa\x00\x31\xf8\x46\xde\x27\xd8\x3b\x53\x4d\xa6\x9e\xa8\x5a\xa5\x39\xf3\xe0\xfd\xf9\x38\x78\x4b\x95\x95\x97\xab\xe9\x06\xa9\x38\xb9\x80\x43\x7b\xde\x76\xef\xf1\xd6\xd8\x0b\xa5\xe4\x9f\x37\x75\xa2\x25\x7a\xfa\x30\xde\x33\x4b\x61\x6a\x19\x2c\x0a\x27\x2c\x6f\x21\x07\xe9\x4f\xf0\x3e\x64\xcd\x19\x95\xe8\x69\x9e\x73\x3e\xfb\x5f\x76\x23\xde\x9a\x8f\x70\x74\xf3\x66\xe4\x8d\xcb\xc9\x85\xe9\xd5\x5e\xc8\xf5\xf0\x27\x68\x25\x60\x9d\xd3\x11\x8c\x42\x79\x64\x85\xd2\x07\xca\xc1\xe2\xc3\x23\xa8\xe9\xda\x55\xad\xf9\x18\x73\xf8\xad\xbc\x46\x2f\x7c\x9a\xb6\x2d\xc1\x49\xd4\xfd\x1d\x00\x9a\xc6\x87\xf7\xa6\x05\x00\x00")
+
+func heap_tmpl() ([]byte, error) {
+	return bindata_read(
+		_heap_tmpl,
+		"heap.tmpl",
+	)
+}
+
+var _index_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\x31\x6b\xc3\x40\x0c\x85\xe7\xbb\x5f\xf1\x46\x1b\x1a\x9b\xac\x85\x0e\x5d\x3a\x76\x28\xdd\x4a\x07\xc7\xd6\x39\x02\x47\x17\x64\x39\x34\x1c\xf7\xdf\xcb\xd9\x29\x6d\x87\x80\x96\x27\xbd\xf7\xf1\x50\xdb\x22\xa5\xe6\xb5\x3b\x51\xce\x50\xb2\x45\x65\x86\x1d\x09\x2c\x03\x7d\x21\x86\x55\x04\xd6\xd9\x40\x13\x9d\x48\xac\x2c\x53\x6a\x5e\xa6\xe1\x16\xb3\x63\x67\xe0\x19\x5d\xdf\xd3\xd9\x68\xc0\xe1\xba\xa6\x46\xbe\x90\x20\x2c\xd2\x1b\x47\x79\xf0\x6d\x8b\xa8\xd8\xed\xc1\x2b\x55\xa9\x84\x24\x0a\x35\xbe\x98\x50\xa5\xd4\xbc\xf5\x97\x1b\x75\x13\xef\xd7\x33\xe5\x5c\xff\x96\xac\xc2\x86\x2c\xe6\x67\x1d\x7f\xee\x87\x18\xa7\x1a\x2c\x86\xe4\x5d\x88\x0a\xc6\xe3\x13\xb4\x93\x91\xf0\x17\xdb\xfc\x6b\x9e\xbc\x73\x1c\x10\xa4\xba\xeb\xf9\xe0\xcf\xba\x30\x9d\xdb\xbe\x03\xf6\xce\x65\x5f\x46\xc9\x16\x15\xec\xf6\x3e\x7f\x0f\x00\x3c\xb4\x25\x56\x47\x01\x00\x00")
+
+func index_tmpl() ([]byte, error) {
+	return bindata_read(
+		_index_tmpl,
+		"index.tmpl",
+	)
+}
+
+var _index_by_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xcf\x6a\xf3\x30\x10\xc4\xcf\xd6\x53\xcc\x77\x8b\xc1\xd8\xf7\xaf\xf8\xd0\x4b\xa0\x94\xf6\x10\x4a\x2f\x21\x14\x25\x5e\xc7\x22\xb2\x54\x24\xd9\x21\x35\x7a\xf7\x22\xdb\xf9\x63\xda\x94\x04\x7c\xb0\xb4\x33\xbb\xb3\xfa\x65\x19\xba\x2e\x7d\xe5\x35\x79\x0f\x43\xae\x31\xca\xc2\x55\x04\x92\x54\x93\x72\x16\xba\x0c\x8a\xb9\x2c\x46\xd1\xfa\x10\xea\xc2\x84\xdb\x17\x61\x37\xe9\x33\x1d\xbc\x4f\x59\x96\xe1\xa9\x84\xa5\x96\x0c\x97\x67\x7b\xc5\x5b\x0a\x06\x58\x5e\xd3\xd4\x93\xf4\xf7\xa5\x30\xd6\x41\x2b\x82\xb0\x68\x2c\x15\x29\x2b\x1b\xb5\xc1\xac\xeb\xd2\xc5\xa6\x1d\xa7\x0e\x87\xb7\xc3\x27\x79\x1f\x9f\x23\xcf\xfa\xff\x05\xb9\x77\x2e\x6d\x90\xb1\xc8\x90\x6d\xa4\xc3\xff\x1c\x35\xdf\xd1\xec\xb2\x9c\x40\x92\x9a\xf4\x4d\x2f\x57\x8b\x63\x16\x95\xda\x40\x04\xb3\xe1\x6a\x4b\xb8\xaa\x0d\x93\x22\x51\xe2\x23\x81\xde\xf5\xfa\x7e\xec\xf2\xaa\x61\x29\x56\xe9\x64\xfb\xd5\x03\xfe\xe9\x5d\xdf\x27\xba\xdf\x8c\xfc\x7a\xb6\xa5\x58\xb1\x28\xf2\x2c\x7c\x03\xd2\x31\x1d\xf3\x2c\x60\x9a\x0b\x55\xfc\x0e\x7d\x60\x31\xb2\xfb\x41\x7e\x2f\x5c\xd5\x23\xdb\x8a\x96\xd4\x94\x65\xe8\xcb\x55\x01\xae\xec\x9e\x8c\xc5\xbe\x22\x57\x91\x09\x72\xd3\x93\xd5\x8a\x6e\x00\x3b\x89\x16\x48\x3d\x9a\xed\x59\x78\x1c\x77\x54\x8f\x82\xe1\x98\x60\xad\xb5\x8c\xd1\xdd\x0f\xf1\xf6\x77\x47\x9e\x63\x9a\x6a\xc0\x17\x9e\xf0\xcf\x36\x09\x9c\x69\xe8\x84\xa5\xe5\x06\x5f\x64\xf4\xd8\x6c\xd8\xe0\x44\x2b\x54\x12\x94\x5c\x5a\x62\xfe\x7b\x00\x62\x2a\x15\x39\xa2\x03\x00\x00")
+
+func index_by_tmpl() ([]byte, error) {
+	return bindata_read(
+		_index_by_tmpl,
+		"index_by.tmpl",
+	)
+}
+
+var _iter_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x90\xcf\x4b\xc3\x30\x14\xc7\xcf\xcd\x5f\xf1\xf5\xd6\xc1\x9a\xdd\x05\x0f\x5e\xbc\x88\x1e\x86\x78\x11\x0f\xb1\x7d\x99\x61\x59\x52\x92\xb4\x50\x1e\xf9\xdf\x25\xed\xc4\x89\x0c\x51\xd8\xa1\xf0\x4a\xde\xf7\xc7\xfb\x30\x1b\x0d\xf9\x60\x62\x2b\xef\x69\x7a\x9a\x7a\x42\x93\xb3\xd8\x6c\xc0\x2c\x1f\xd5\x81\x72\x46\xa0\x34\x04\x17\xa1\x1c\x4c\xa2\xa0\x92\x0f\xf0\x23\x05\xa4\x77\xc2\x9e\xa6\x66\x54\x76\x20\xf4\xca\x84\x08\xaf\x8b\xf2\xce\x76\x8b\x78\x0d\xe3\x30\xb8\xd8\x53\x6b\xb4\xa1\x0e\x3e\x74\x14\xa4\xd0\x83\x6b\x51\x33\xcb\x6d\x3b\x1e\x63\x96\x9f\x52\x21\xe7\xd5\x57\x7c\x3d\xcf\x5b\x4a\xcf\xca\xc6\xb2\x26\xaa\xa5\x10\x8a\x47\x3d\x19\xb2\xdd\x32\x32\x7f\x3b\xa4\x84\x33\xcb\xdb\xb0\xfb\xf4\x7c\xf3\xde\xae\x8a\x41\xa5\x7d\xc0\x7e\x8d\x11\xd7\x37\x08\xca\xed\x08\xa7\x55\xe4\xe9\x05\xf3\x7e\x65\x34\xae\xe6\xa8\xba\xc8\x16\x93\xea\x58\xa4\xbc\x67\x31\x7f\x59\x64\xc1\xdc\x80\x6c\xfc\x2b\x48\xb2\x74\x20\x97\x7e\x10\xbc\x18\xab\xb3\x60\xcc\x3f\xa8\x9c\x5d\x7c\x31\xaf\xbf\xd3\x72\x5d\xce\x1f\x03\x00\xa0\xb9\x66\x72\x8a\x02\x00\x00")
+
+func iter_tmpl() ([]byte, error) {
+	return bindata_read(
+		_iter_tmpl,
+		"iter.tmpl",
+	)
+}
+
+var _iter_filter_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x92\x31\xab\xdb\x30\x14\x85\x67\xeb\x57\x9c\x2e\x21\x01\xc7\xd9\x0b\x1d\xba\x64\x29\xed\x10\xda\x2e\xa5\x83\x22\x5f\x25\x97\x28\x92\x91\x64\x17\x23\xf4\xdf\x8b\x6c\x97\xe6\x0d\x79\x8f\xe4\x0d\x06\x5f\x7c\xce\xf1\xb9\xfa\x94\x12\x6b\x34\x5f\x39\xa8\xe6\x0b\x8d\xdf\xc7\x8e\xb0\xcd\x59\xec\x76\x48\xa9\xf9\x26\xaf\x94\x33\x3c\xc5\xde\xdb\x00\x69\xc1\x91\xbc\x8c\xce\xc3\x0d\xe4\x11\xcf\x84\x0b\x8d\xdb\x41\x9a\x9e\xd0\x49\xf6\x01\x4e\x17\xe7\xde\xb4\x8b\x39\x9e\x65\x84\xf4\x04\xa9\x14\x75\x91\x5a\x1c\xc7\xc9\x78\xe2\x81\x2c\x74\x6f\x55\x64\x67\x6b\xb0\x45\x6f\x43\x47\x8a\x35\x53\x0b\xe7\x5b\xf2\x8d\x28\xdf\xb1\x4e\xa9\x39\xa8\x61\x49\x9c\x87\x52\x35\xe7\xcd\xff\x9a\x6b\x3d\xa7\x15\xf1\xed\x3e\x39\xd7\x45\xf4\xd9\x9f\xfe\x59\x8e\xce\x99\xc9\x78\xa0\xf8\x53\x9a\x50\x32\x45\x35\x6f\x39\x47\x8c\x4c\xa6\x7d\x2c\x4d\x54\x95\x76\x1e\x97\x1a\x03\x3e\x7e\x82\x97\xf6\x44\xb8\xed\xdd\xbc\x38\x96\x24\xaa\xaa\x62\x0d\x6d\xd7\xc5\xb2\xc1\x6a\x85\x0f\xd3\x6f\x97\x79\x12\x2c\xa5\xca\x6b\x16\xd3\x93\x45\x16\x29\x6d\x41\x26\x3c\x4a\x8a\x0c\x5d\xc9\xc6\xa7\x11\x35\xe5\x56\xfc\xb0\x86\x2f\x84\x3d\x9b\x48\xbe\x06\x47\xb4\x8e\x02\xac\x8b\x90\xc6\x38\x25\x23\x41\xc2\xd2\x1f\x04\xc3\x8a\x9e\x05\xf8\x0e\x5a\x77\xd1\xf0\x83\x5c\xee\x8a\x7e\xf1\xef\x5b\x5e\xaf\xeb\xde\xe2\x68\xdb\x9c\xff\x0e\x00\xfa\xf7\x3a\xd2\x85\x03\x00\x00")
+
+func iter_filter_tmpl() ([]byte, error) {
+	return bindata_read(
+		_iter_filter_tmpl,
+		"iter_filter.tmpl",
+	)
+}
+
+var _iter_map_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\x31\x8b\xdc\x30\x10\x85\xeb\xf5\xaf\x78\xe9\x76\xc1\xeb\xeb\x03\x29\xd2\xa4\x09\x49\xb1\x24\x69\x42\x0a\x45\x1a\xed\x0e\xab\x1d\x19\x49\x76\x30\x42\xff\x3d\x48\x36\x64\x73\x70\x21\x77\x5c\x61\x83\xa5\x37\x6f\xde\xcc\xe7\x9c\xd9\x62\xf8\xc4\x51\x0f\x1f\x69\xf9\xb2\x8c\x84\x63\x29\xdd\xc3\x03\x72\x1e\x3e\xab\x1b\x95\x82\x40\x69\x0a\x12\xa1\x04\x9c\x28\xa8\xe4\x03\xfc\x4c\x01\xe9\x42\xb8\xd2\x12\xe1\x6d\x95\x7f\x70\x66\xab\x50\x62\xda\x65\xa0\x38\xb9\xd4\xee\xb5\x72\x8e\xe5\xdc\x8e\xcf\x3c\x93\xc0\x4e\xa2\x13\x7b\x81\xf5\x01\xa4\xf4\xa5\x7a\x1d\x67\xe5\x26\xc2\xa8\x38\xf4\x60\xc1\x24\x71\x24\xcd\x96\xc9\xc0\x07\x43\x61\xe8\x6a\x1d\xf6\x39\x0f\x27\x3d\x6f\xfd\xd6\x8f\x9a\xbe\x94\xc3\x9f\xe4\x7b\xbb\x76\xa9\xe2\xfb\x11\x4b\xe9\xab\xe8\x7d\x38\xdf\x95\x34\xc1\xa9\x05\xde\x4e\x4e\x94\xbe\x29\x17\xab\x7f\xb7\x5b\x97\xb0\xda\x2d\x4c\xce\xfc\xcb\xf9\x91\xd7\x4f\xef\xdd\xa1\x9a\xec\xea\xa8\xd7\x1e\x33\xde\xbe\x43\x50\x72\x26\xdc\xcf\x31\xfc\xb5\xc4\xaa\xdf\xb1\xc5\x9b\xd6\x6e\x7f\xed\x61\xa5\xbe\xe7\xc3\xea\xb5\xdb\x32\x55\x59\xe9\xda\x53\xba\xd2\xe5\x7c\x04\xb9\xf8\x5c\x8e\xcf\x44\x45\x8e\x6e\x24\xe9\x31\xf9\xa1\xfe\x39\x5f\xc5\xf1\x95\x5a\xf9\x4d\x8d\x23\x05\xdc\x28\x5d\xbc\x89\x3d\x38\xc1\x78\x8a\x10\x9f\xa0\x9c\xf3\x5a\x25\x82\x82\xd0\x2f\x44\xc7\x9a\x5e\x4a\xf7\x35\x51\x3e\xc5\x8d\x5f\x00\xcd\xca\xfe\x49\xed\x77\xfe\xf1\x1f\x24\xc5\x94\xf2\x7b\x00\x1d\xc2\x7e\x15\xa5\x03\x00\x00")
+
+func iter_map_tmpl() ([]byte, error) {
+	return bindata_read(
+		_iter_map_tmpl,
+		"iter_map.tmpl",
+	)
+}
+
+var _keys_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\xb1\x4e\x33\x31\x10\x84\xeb\xf3\x53\x4c\x79\x96\xee\x77\xfe\x3a\x22\x2d\x0d\x82\x82\xd0\x45\x29\x0e\x7b\x2f\x38\xb9\xac\x4f\xf6\x05\x29\x5a\xf9\xdd\x91\xe3\x08\x82\x80\xce\xd6\xce\xce\xb7\x33\x8b\x05\x44\xcc\x53\x7f\xa4\x9c\x11\x69\x3e\x45\x4e\x98\xdf\x08\x07\x3a\x27\x84\xa1\x4c\xef\x47\x57\x05\x22\x7e\x80\x79\xf4\xc9\x9a\x75\x88\x33\xb9\x9c\xe1\x19\x7d\xb2\xc4\xce\xf3\x0e\x21\x3a\x8a\x22\x34\xa6\x62\xe7\x19\x27\x4e\x13\x59\x3f\x78\x72\x9f\x43\x76\x39\x1b\x35\x9c\xd8\xa2\x15\x31\xcf\xf6\xfd\x8a\xaf\x9f\x97\xf3\x44\x39\xeb\xaf\xb3\x5a\x8d\xcd\x56\xa4\x72\x1f\xe8\x5c\x05\x10\xd5\x5c\x6e\x5c\xae\x70\xec\x0f\xd4\xfe\xa2\xe9\xf0\xbf\xc3\x48\xfc\x0d\x63\x6e\x13\x69\xad\x9a\x21\xc4\x92\x16\xcb\x15\x62\xcf\x3b\xc2\x9f\xea\xc2\xac\xd0\x15\xfa\x69\x22\x76\x6d\xf9\x75\x65\x5d\xab\x26\xab\x46\xe4\x1f\x7e\x74\xa4\x9a\x14\xe2\x6c\xd6\xa3\xb7\x74\x5d\x28\xe9\x5b\xdf\x61\x0f\xcf\xb3\xc6\x6b\x08\x23\xe4\xda\x7f\x71\x4b\x1b\xbf\xc5\x5d\x7d\xed\xb7\xc8\xba\x5a\x5f\xba\x53\xcd\x8d\x4e\xe5\x8f\x01\x00\x05\xbf\xd1\x52\xc2\x01\x00\x00")
+
+func keys_tmpl() ([]byte, error) {
+	return bindata_read(
+		_keys_tmpl,
+		"keys.tmpl",
+	)
+}
+
 var _len_swap_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xce\xc1\x4a\xc4\x30\x18\x04\xe0\xb3\x81\xbc\xc3\x1c\x1b\x58\x92\x27\xf0\xea\x49\x3c\xa8\x37\xe9\x21\xa6\xff\xb2\x7f\x49\xff\x96\x26\xb5\x4a\xc8\xbb\x4b\xb7\x20\x78\xd8\xb2\xc7\x61\x98\xe1\x73\x0e\xcf\x24\xe0\x84\x7c\x21\xc8\x32\x7c\xd2\x8c\xf1\x0c\x8a\x34\x90\xe4\x04\x96\x6b\x13\xc6\x18\x29\x64\x1e\xc5\x6a\x75\x5e\x24\xa0\x29\xc5\xbe\x86\xaf\x17\x3f\x50\xad\xd8\xc3\xfb\xcf\x44\xb5\x9a\xed\xb2\x31\x60\xc9\x28\x5a\x3d\xcc\x94\x97\x59\x10\x49\xfe\x6d\x6c\x29\xf6\x29\x76\x7b\x30\x5a\x55\xad\xb4\x72\x0e\x6f\xab\x9f\x90\x56\x3f\xed\xa6\x3f\xc9\xca\xf9\x02\x96\x8e\xbe\x29\x81\xe1\xa5\x43\x7f\x0f\x66\xfb\x6b\xf8\x84\x7e\x03\x99\xab\xe8\x26\xe3\x83\xdb\x13\x6e\xb7\x7d\x8b\xc7\xc3\xfa\x68\xcc\xad\x56\xf5\x37\x00\x00\xff\xff\x14\x93\x09\x30\x70\x01\x00\x00")
 
 func len_swap_tmpl() ([]byte, error) {
@@ -70,6 +322,15 @@ func less_tmpl() ([]byte, error) {
 	)
 }
 
+var _lookup_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8f\x3f\x6f\x83\x30\x10\xc5\x67\xfc\x29\xde\x08\x12\x32\x7b\xa5\x0e\x5d\xba\x54\xed\x50\x75\xab\x3a\x50\x7c\x01\xcb\xc4\x8e\xfc\x87\xc8\xb2\xfc\xdd\x23\x30\x49\x18\x9f\xee\xf7\xee\x7e\xd7\x75\x48\x89\x7f\xf5\x67\xca\x19\x96\x7c\xb0\xda\xc1\x4f\x84\xa5\x9f\x03\xc1\x79\x63\x49\x40\xea\x95\x7a\x9f\xc5\x0e\x06\x2d\xc8\x6e\xd8\x28\x17\xd2\x50\x14\x5b\xf4\x5a\xe0\x3a\x91\x9f\xca\xc8\x12\xa4\x83\xd1\xc4\xd9\x29\xe8\x01\x75\x4a\xfc\x7b\x58\xf6\x0d\x25\xfc\xc4\x0b\xe5\xdc\x3c\x15\x6a\x45\x71\x4d\x9f\xd2\x0d\xfc\x83\xe2\x1d\x58\xcb\x6f\x76\x2c\xb1\xc5\xbf\x31\x73\x83\xc4\xaa\xcd\xb2\x85\x51\x78\x79\xc5\xf1\x00\x3f\xfa\xfe\x2a\x8a\x7f\xac\x2a\xef\xe1\xd1\x61\xf9\x36\x00\xe5\x3f\x2c\xa9\xfe\x00\x00\x00")
+
+func lookup_tmpl() ([]byte, error) {
+	return bindata_read(
+		_lookup_tmpl,
+		"lookup.tmpl",
+	)
+}
+
 var _mapper_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\xb1\x6a\xc4\x30\x0c\x86\xe7\x0b\xe4\x1d\xfe\x31\x81\xe2\xdb\x0b\x37\x74\xe9\xd8\xe1\x28\x5d\x8e\x1b\x8c\x2b\x3b\xa6\x8e\x52\x6c\xe7\x8e\x62\xfc\xee\x45\x49\xa0\xe9\xd0\x8e\x92\x7e\xe9\xfb\xd0\xf1\x88\x52\xd4\x8b\x1e\xa9\x56\x44\xca\x73\xe4\x04\x0d\xa6\x3b\x52\xf0\x86\x70\xf7\x79\x40\x1e\x08\x91\xd2\x1c\x72\xc2\x64\x61\x74\x08\x9e\xdd\xd2\x76\xfe\x46\x0c\x3b\xb3\xc9\x7e\x62\xd8\x29\x82\xb4\x19\x40\x81\x46\xe2\x2c\xf1\x52\xd4\x73\x78\x5f\x19\xaa\x6d\x24\x8b\xae\x14\x75\x36\xb7\x0d\xbc\x16\xaf\x5f\x9f\x54\x6b\xff\x23\xd4\x59\x96\xe2\x29\xba\xdd\xe8\x4c\xf9\x4d\x87\x24\x5b\x6d\x73\x58\xad\xf0\x78\xc2\xa8\x3f\xa8\xdb\xcf\x1f\x10\x88\x7f\x71\xd4\xde\xa4\xef\xdb\xe6\x20\xba\x5e\xb6\xa3\x66\x47\xf8\x33\xbc\xb0\x36\xd8\xc5\x5f\x71\x82\xfd\xe7\xf4\xc5\x5f\xe5\x78\x5d\xfc\xe4\xa5\xdb\xf3\xda\xa6\x7e\x07\x00\x00\xff\xff\xab\xc2\xc6\xec\x70\x01\x00\x00")
 
 func mapper_tmpl() ([]byte, error) {
@@ -79,7 +340,43 @@ func mapper_tmpl() ([]byte, error) {
 	)
 }
 
-var _new_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8d\xb1\x0a\xc2\x40\x0c\x86\xe7\x16\xfa\x0e\xa1\x93\x2e\xd7\x67\x70\x71\xd3\x41\xc4\x3d\x9c\xb1\x0d\x9c\x87\x5c\xd2\x16\x09\x79\x77\xe9\x81\x88\xeb\xf7\xf1\x7f\xff\x30\x80\x59\x38\xe3\x93\xdc\x21\x16\x42\x25\x01\x84\x4c\xeb\xc6\x2f\x71\xb9\xbe\x5f\x9b\x5a\x59\x27\xd0\x89\x60\xe4\x85\x32\x70\x66\x65\x4c\xb0\x60\x9a\x49\x42\xd7\x3e\xe6\x1c\x7f\xa5\x9d\x59\x38\xb1\xc4\x70\x28\xa3\xb8\xef\x6b\x8b\xf4\x86\x49\xdc\xc1\xba\xb6\x29\xa4\x73\xc9\x7f\x1f\x66\xbd\xf5\xee\x5d\xdb\x34\xdf\xf9\x91\x29\xdd\xa5\x32\xb3\xde\xab\xf5\x4f\x00\x00\x00\xff\xff\xbd\x7c\x04\x5b\xb3\x00\x00\x00")
+var _mapper_err_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x52\x4f\x8b\xdb\x3e\x10\x3d\x4b\x9f\x62\x7e\x0b\xcb\xcf\x06\x57\xb9\x6f\xc9\xa1\x2c\x5b\x68\xa1\x3d\x2c\xbd\x85\x1c\x8c\x3d\x4e\xd4\x2a\x52\x90\x94\x3f\x45\xe8\xbb\x17\x8d\x9c\xd8\x4e\x1c\x28\xf4\x64\xc6\x9a\x79\xef\xcd\x7b\xb3\x58\x40\x08\xe2\x7b\xbd\xc3\x18\xc1\xa2\x3f\x58\xed\xa0\x06\x8d\x27\x70\x4a\x36\x08\x27\xe9\xb7\xe0\xb7\x08\x16\xdd\x41\x79\x07\xa6\x83\xa6\x56\x4a\xea\x0d\xfd\xde\xc8\x23\x6a\xe8\x0e\xba\xf1\xd2\x68\xe8\x8c\x05\xac\x9b\x2d\xa0\xc2\x1d\x6a\x9f\xda\x43\x10\x9f\x55\x9b\x39\x04\x0f\xe1\x03\xc8\x0e\xc4\x37\xe9\x1a\xf1\xd5\x48\x1d\x23\x5f\x2c\xe0\x8b\x1f\xe8\x95\x02\xb4\xd6\x58\x57\x41\xad\x8c\xde\x0c\x22\xa4\x6e\xf1\x8c\x24\x22\x95\x3d\x89\xab\xe0\xa7\x91\x1a\xdb\x8c\x8e\xca\xe1\x40\xf1\x66\xed\x95\xc1\x79\xb3\x77\x50\x7b\x52\xde\x49\xeb\x7c\x26\xaa\xe0\xb4\x95\xcd\x16\xa4\xeb\x45\x60\x3b\xcb\x7c\xc3\xdb\xd3\xe9\x36\xc6\xe9\x5a\xaf\xfe\x7c\xc3\x29\xbb\x91\x5b\x8d\xd1\x1e\xcf\x3e\xd1\xb5\x46\x63\x05\x52\xf7\x02\x9a\xda\x21\x35\xf6\x2d\xff\xbb\x2c\x70\xac\x6c\xcc\x9a\x7c\x87\x22\x04\xf1\xde\x1c\xfb\x10\x73\xf1\xe3\xf7\x1e\x63\x2c\x87\x70\x8b\x10\xa6\xf2\x1a\x7f\xbe\x08\x11\xaf\xf9\x5b\x41\x08\x84\xdb\xe5\x44\xef\x67\x1e\xf4\x87\x20\x3e\xd9\xcd\xc0\x79\x9d\x22\xf3\x93\x3e\xaa\xde\xe9\x84\x62\xac\xf2\x52\x65\x08\x29\xaa\x18\x6f\xdf\x7b\xd4\x12\x8a\xd5\xfa\xd1\x2c\x04\xce\xf2\x49\xc2\xcb\x12\x76\xf5\x2f\x9c\x6d\x56\xa8\x27\xf6\x88\xf1\x31\x96\x25\x67\x73\xe7\xc8\x8e\xb5\x4d\x12\x1d\xac\xd6\xc4\x96\xdb\x48\x14\x67\xe9\xc4\x65\x22\xb5\xb5\xde\x20\x3c\x44\x4f\x0a\xa7\xf0\xe4\x21\x67\x4c\x76\x09\x3d\x41\x34\xfe\x2c\xde\xac\x2d\xca\x8f\xf4\xe7\xbf\x25\x68\xa9\x68\x90\xe5\xb8\x53\x4d\x2b\x73\xc6\x22\x67\x63\x1d\x53\x6c\x72\x9a\xb3\x8b\x74\xe8\x75\x27\xae\x6c\xd3\x4a\xae\x09\x08\x96\xd0\xe9\xb9\x6b\x18\xa7\x39\xbf\xd1\x4a\xae\x67\x84\xce\x3a\xc8\x18\xf9\xb7\x84\x7a\xbf\x47\xdd\x16\xa9\xaa\xa0\xdb\xf9\xb4\xae\xb1\x5d\xf1\x34\x01\x7e\x6e\xd7\x2f\xf0\x7c\x7a\xaa\x40\x92\xc8\x94\x4c\xbf\x2c\x5d\xc8\xad\x21\x7f\x0f\xc4\xd9\xd4\xb4\xc8\x6f\x70\xaf\xee\xfc\xa3\x31\x17\xdc\xd4\xc8\x53\x56\xb3\xbe\x0c\xd1\x53\x40\x8e\x1e\xc8\x1d\x21\xc4\xbd\xb9\x77\x47\x10\xf9\x98\xa5\x7f\xce\x2b\x54\xa0\xa5\xe2\xf1\xcf\x00\x06\xcd\x84\x8a\xd2\x05\x00\x00")
+
+func mapper_err_tmpl() ([]byte, error) {
+	return bindata_read(
+		_mapper_err_tmpl,
+		"mapper_err.tmpl",
+	)
+}
+
+var _mapper_map_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\xbd\x6a\x84\x40\x14\x85\x6b\x7d\x8a\x53\x2a\x88\xdb\x2f\x58\xa4\x49\x99\x62\x09\x69\x42\x8a\x8b\x7b\x47\xc5\xf1\xba\xcc\x8f\x8b\x0c\xf3\xee\x61\x54\x88\x29\xb6\x3c\x73\xe6\x9b\xf3\x31\x97\x0b\x42\xa8\x3f\x68\xe2\x18\x61\xd8\x79\x23\x16\x04\xe1\x27\x26\x7a\xe0\x39\xb8\x1e\xae\x67\x18\xb6\x5e\x3b\x8b\x59\xa1\x25\xad\x07\xe9\xb6\xe3\x6e\x58\x58\xa0\xbc\xb4\x6e\x98\x05\x6a\x36\x60\x6a\x7b\x2c\xa4\x3d\xa7\xcb\x21\xd4\xef\xfa\xbe\xbf\x5f\xc1\xcb\x9d\xcd\x06\x5a\x9a\x18\x23\xaf\xb6\xce\x13\x8d\x22\x84\xfa\xd6\x2e\x87\xc8\x1e\x3e\xd7\x07\xc7\x58\xfe\x09\x16\x4a\x52\x78\x33\xdd\xa9\xba\xb1\xfb\x22\x6d\x13\x95\x67\xbb\x26\xae\x0d\x26\x1a\xb9\x38\xd7\x15\x34\xcb\xbf\x99\xfa\x2c\x57\x96\x79\x96\xf4\x47\x5e\xab\x43\xff\xda\xc0\x90\x74\x8c\x97\x50\x9a\x3c\x36\xbf\x47\x5e\x7f\xd0\x40\x49\xb1\xd1\x65\x9e\xc5\xe4\x93\x7e\x14\x86\xad\xd7\x2e\x8f\xbf\x03\x00\x9d\x13\xf4\x85\x6e\x01\x00\x00")
+
+func mapper_map_tmpl() ([]byte, error) {
+	return bindata_read(
+		_mapper_map_tmpl,
+		"mapper_map.tmpl",
+	)
+}
+
+var _mapper_parallel_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x4b\x6f\xdc\x36\x10\x3e\x53\xbf\x62\x1a\x20\xa8\x84\xc8\x74\x7a\xf5\xe3\xe0\xba\x4e\xd0\x02\x69\x0a\x37\x80\x0b\x2c\xf6\x20\x53\x23\x2d\x6b\x2e\x69\x90\x5c\xef\x1a\x04\xff\x7b\x41\x52\xaf\x95\x65\xb4\x2e\x72\xb2\xc5\xc7\xf7\x98\xf9\x38\x7b\x7a\x0a\xce\xd1\xdf\xab\x2d\x7a\x0f\x1a\xed\x4e\x4b\x03\x15\x48\xdc\x83\x11\x9c\x21\xec\xb9\xdd\x80\xdd\x20\x68\x34\x3b\x61\x0d\xa8\x06\x58\x25\x04\x97\x6d\x5c\x6e\xf9\x13\x4a\x68\x76\x92\x59\xae\x24\x34\x4a\x03\x56\x6c\x03\x28\x70\x8b\xd2\x86\xe3\xce\xd1\x4f\xa2\x4e\x1c\x34\x3b\x3d\x85\x6f\x1b\x1c\x6f\x70\x13\xf1\xb0\x86\xfb\x67\x70\x8e\x37\x40\xbf\x70\xc3\xe8\x9d\xd2\x0f\xa8\x8d\xf7\xce\xbd\x58\x40\x61\xd0\xfb\xcf\x5f\xbf\x5c\xfd\xf5\xc7\xed\xd7\xeb\x3f\x9d\x43\x59\x7b\x0f\xad\xd2\x6a\x67\xb9\x44\x03\x4c\x49\xb6\xd3\x1a\xa5\x15\xcf\xe7\x47\x06\x34\xda\x8a\xcb\xb8\xa4\x74\x8d\x3a\x48\x0c\x1f\x9d\x62\x43\x33\xe7\x4e\x60\xd0\xf1\x9b\xe2\xd2\xfb\x20\xfb\x57\x3b\x56\x48\x08\x40\xad\x95\x36\x25\x54\x42\xc9\x76\xac\x13\x97\x35\x1e\xd0\xcc\x51\x4b\xf8\x5b\x71\x89\x75\x42\x0f\x06\x46\x8a\x1b\xad\x07\x06\x63\xd5\xa3\x81\xca\xc6\xcb\x0d\xd7\xc6\x26\xa2\x12\xf6\x1b\xce\x36\xc0\x4d\x27\x02\xeb\x45\xe6\x19\x6f\x47\x17\xaa\x73\x6c\xeb\xda\x1e\x66\x9c\xbc\x99\x34\x94\x29\x69\xf1\x60\x03\x5d\xad\x24\x96\xc0\x65\x27\x80\x55\x06\xe3\xc1\xee\xc8\x8f\x26\x09\x9c\x2a\x9b\xb2\x86\x46\x43\xee\x1c\xbd\x65\x4f\x5d\xce\xd2\xc7\xb7\xe7\x47\xf4\xbe\x18\xf3\x97\x4f\xba\x1f\xe5\x31\x7b\xe8\x85\xd0\xeb\xf4\xb7\x84\xae\xd7\x4d\x0a\xdd\xcb\x3b\xaf\x9c\x77\x8e\x5e\xe9\x76\xe4\x1c\x6e\xc5\xe2\xe7\x7d\xc8\x6e\x63\x48\xbc\x2f\x93\xa9\xa2\xcf\xda\x7c\xbf\x43\x3d\x42\xfa\x14\x9e\xc5\xbd\x40\xef\xf3\xd5\xfa\xdf\x00\x57\xeb\x57\x20\xc1\x65\xe4\xb8\x53\x95\x64\x28\xbc\xcf\x08\xb3\x87\x12\x58\xfc\x84\xb3\xcb\xa1\x34\x77\xdc\x6e\xae\xe3\xea\x52\x05\x7b\xc2\xfe\xf4\xcf\x15\x7b\x68\xb5\xda\xc9\x3a\x2f\x7a\x17\x19\xa9\xb1\x41\xdd\x61\xe7\x45\x52\x10\xe5\x64\x24\x3d\xfc\x40\xb8\xad\x1e\x70\xd1\x9a\x40\x79\xd4\x61\x3a\x7d\xf2\x45\x31\x33\x14\x2b\x9e\x11\xd4\xda\x4c\x50\xbb\x94\xff\x27\xa8\x4e\x99\xc4\xc3\xa8\x8b\x6d\x2a\x09\x5c\xda\x22\x23\x4f\x95\x86\x7d\x0b\xe6\x59\x32\x7a\x57\x71\xfb\x59\xab\xdd\x63\x46\xc2\x6c\xda\xc7\xa9\x12\x68\x3f\x9e\xf7\x1f\x17\x6f\x1b\x3b\x7a\x27\x2d\xdf\x22\x1d\xc7\x4f\xfe\xb1\xaf\x64\x8f\xf9\xe1\x43\x68\x23\xd9\xb7\xf4\xaa\xae\xf3\x9f\x8a\x8c\x90\x56\xa5\xc0\x16\x71\xa7\x2b\xf8\xbe\xa5\xbf\x28\x89\xa1\xe2\x24\xea\xe3\x41\x9a\xae\x64\x8b\x10\xdd\xc5\xb3\x64\x69\x20\x85\xf5\xd4\x9a\x15\x5f\xc7\x74\x99\x15\x5f\xc3\x25\x34\x72\x29\x06\xd3\x87\xb0\x5c\xdc\x15\x5f\x17\x03\xdb\xd2\x80\x0a\x7b\xbc\x81\xef\x4e\x7a\x3e\xe0\xfc\x70\x09\x92\x8b\x54\x20\x42\xc6\x34\x12\x42\xfc\x91\xb4\xb9\xff\xef\x66\x3b\x1c\xcd\x3a\x3a\x1f\xa8\xfd\x6b\xaf\xb1\x41\xac\xcf\xb2\x79\xd3\x5e\xa5\x89\xa6\x0c\x0a\x64\x36\xfe\x1b\xc7\x68\x6c\xf1\xc5\x09\xf0\xb3\x7e\xe5\xe2\x84\xd9\x43\x97\x89\xb0\x48\xee\x35\x56\x0f\x10\xc8\xb2\xa8\xca\x67\x47\x45\x78\x13\xfd\x40\x37\xc2\x24\xbf\x4c\x28\x83\x79\xd8\x2e\xb2\x10\xda\xf0\x68\xf2\x62\xe6\xbc\xcf\xdd\x8c\x32\xf4\x2e\xa2\xf3\x66\xb1\x8f\xfd\xda\x25\x34\x5b\x4b\x6f\xc2\x2b\x6f\xf2\x77\x53\x6d\xab\xf7\xf5\xfa\x0c\xde\xef\xdf\x95\xc0\x87\x4c\x15\x83\xdd\x84\x1b\xde\x45\x1c\x11\x26\x0a\xc9\x51\x6b\x43\x29\x4d\xe1\x99\x12\xa6\xdf\xa0\x90\xa3\x88\x75\x54\xb1\x17\x89\x7e\xa3\x97\x29\xf6\xff\x74\x33\xa9\xfa\x72\xae\x26\x7e\x43\x14\x6e\xb4\xce\xdf\x60\xb2\x1f\xd9\x71\x3b\xbd\xd4\xc5\x5f\xa8\x32\xdc\x75\x0e\x65\xed\x7d\xe6\xff\x19\x00\xd7\x0e\xd4\x92\x06\x0a\x00\x00")
+
+func mapper_parallel_tmpl() ([]byte, error) {
+	return bindata_read(
+		_mapper_parallel_tmpl,
+		"mapper_parallel.tmpl",
+	)
+}
+
+var _min_max_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xbd\x4e\xf3\x30\x18\x85\x67\xfb\x2a\xce\xd8\xa8\x51\xd2\x6f\xfd\x4a\x07\x24\xc4\x06\x48\x88\x0d\x75\x08\xe9\x6b\x78\x25\x27\x8e\xfc\x13\xa9\x44\xbe\x77\x64\xbb\x40\x19\x28\xa3\x7d\x7e\xf4\x1c\xbb\x6d\xb1\x2c\xcd\x7d\x37\x50\x8c\xb0\xe4\x83\x1d\x1d\xfc\x1b\xa5\xdb\x3b\x76\x7d\x73\x43\xae\x8f\x11\xa4\x69\xa0\xd1\xc3\xa8\xa4\xdc\xea\xc3\x29\xd2\x8d\x07\x78\x1b\xa8\x96\x6d\x0b\x63\x73\xf4\x9d\xac\xc1\xdc\xe9\x40\x59\x56\x9d\x76\x04\x56\x60\x0f\x76\xa0\x61\xf2\xc7\x46\xaa\x30\xf6\x58\x2d\x4b\xf3\xd8\xcf\xa7\xae\x72\x78\x3a\x4e\x14\x63\xf5\x8d\xb5\xaa\xb2\xef\xda\xbe\x16\xa9\xc6\x8b\x31\xba\xc2\x22\x05\x2b\x68\x1a\x7f\xb4\x34\xe7\x78\x15\x76\x3b\x6c\x92\x53\xcc\x9d\x2d\x60\xe7\x55\x52\x88\xb2\x39\x4b\x75\x41\x95\x22\x4a\x61\xc9\x05\xed\xf1\x7f\x87\x5f\xcb\x9f\x37\x7b\x29\x94\xb1\xe0\x64\xfb\xb7\x05\xe3\xea\x0f\x9c\x2d\x78\xbd\xce\x38\xac\x2e\x14\xf3\xfe\xeb\xf9\x1f\xa6\xfc\x2f\x19\x26\xe5\x3e\xc1\x2e\x71\xf1\x5e\x8a\xb4\x21\xcf\xc8\xeb\x4a\xa8\x86\xb7\x81\x64\xfc\x18\x00\x46\x81\x30\x94\xf4\x01\x00\x00")
+
+func min_max_tmpl() ([]byte, error) {
+	return bindata_read(
+		_min_max_tmpl,
+		"min_max.tmpl",
+	)
+}
+
+var _new_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x90\x41\x4b\x03\x31\x10\x85\xcf\xc9\xaf\x18\xf6\x20\xad\xb4\xe9\xbd\xd0\x83\x17\x6f\x2a\x88\x78\x95\xb0\x3b\xdd\x06\xd6\xec\x92\x64\xb7\xc8\xf0\xfe\xbb\xc4\x5d\x31\x2d\x7a\xf3\xf8\xe6\x25\xf3\xde\x7c\x22\xee\x48\xe6\xc1\xc5\xda\x3c\x0d\xc9\xf5\x9e\xb6\x80\xde\xed\x48\xc4\x3c\xda\x77\x06\xa8\x0e\x6c\x13\x47\xb2\xe4\xf9\x9c\xe7\xcf\xf5\xf4\xf2\x31\x64\xeb\xec\xd2\x89\xd2\x89\xa9\x75\x13\x7b\x72\xde\x25\x67\x3b\x9a\x6c\x37\xe6\x0f\xbe\xa1\xfe\x6b\x69\x34\xfa\x38\xfa\xfa\x67\xe9\xaa\xc8\xbd\x0b\x6d\x04\x44\x4a\xb5\x21\x11\xf6\x0d\xd0\x0f\x29\x92\x31\xe6\xdb\x9e\x4b\x02\x6b\xba\xcd\x4d\x38\xbd\xda\x2e\x02\x24\x5a\x65\x5d\x4f\x4b\xe9\xfd\x81\x6e\xca\xaa\x22\x95\x54\x40\x11\x7b\xef\xb8\x6b\x22\xa0\x95\x12\xb9\x1e\x2d\xe9\x22\x15\xaa\xfc\xe4\xd8\x07\x7a\xdb\xe4\x6b\x68\x7f\xa0\x60\x7d\xcb\x59\xc4\x9c\xab\xfa\x21\xad\xca\xf0\xb5\x56\xd0\x2a\x70\x1a\x83\x5f\x78\xcd\x86\x86\x16\xd9\x12\x77\x91\xff\x0f\xf3\x2f\x68\x4b\x92\x6b\xba\xe6\x74\x51\xec\x82\xce\x1f\x28\x66\x06\xd0\x22\x5b\x62\xdf\x00\x9f\x03\x00\x08\x1b\x29\xe0\x35\x02\x00\x00")
 
 func new_tmpl() ([]byte, error) {
 	return bindata_read(
@@ -88,6 +385,69 @@ func new_tmpl() ([]byte, error) {
 	)
 }
 
+var _option_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8d\x3d\x0a\x02\x31\x10\x46\xeb\xe4\x14\x5f\xa9\x4d\xf6\x04\x16\x36\x96\x16\x8b\xd8\x0f\xeb\xb8\x2b\xac\x41\x92\x49\x40\xc2\xdc\x5d\x42\xc4\x8d\xe5\xfc\xbc\xf7\x86\x01\xa5\xb8\x33\x3d\x59\x15\x81\x25\x05\x1f\x41\x75\x37\xb2\x5c\x69\x8d\xaa\x90\x85\x04\x91\x25\x42\x16\xc6\xfc\xc8\xec\x91\x69\x4d\x0c\x8a\xf5\xf3\xb4\xde\x9a\xc0\xd9\x7b\xf2\xd3\x26\xdc\x95\xe2\x8e\x61\xfe\xda\xdb\x70\x79\xbf\x58\x75\xff\x5f\x28\xd6\xb4\x36\xaa\xa0\x62\xe3\x94\x37\x6c\x9c\xf2\x0f\xb3\xc6\xf4\x67\xd7\xf7\x71\x40\x5f\xb4\x46\xad\x7e\x06\x00\x92\x06\x45\x79\xe1\x00\x00\x00")
+
+func option_tmpl() ([]byte, error) {
+	return bindata_read(
+		_option_tmpl,
+		"option.tmpl",
+	)
+}
+
+var _partition_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x50\xcd\x6a\xe3\x30\x10\x3e\x4b\x4f\x31\xa7\xc5\x59\x1c\xfb\xbe\x90\xc3\xee\xc2\xde\xb6\x94\x50\x7a\x29\x3d\x28\xd2\xd8\x16\xd8\x92\x19\x4d\x02\x41\xe8\xdd\x8b\xfc\x93\x26\x84\x96\x80\x2e\x9a\xef\x6f\xbe\xa9\x6b\x88\xb1\x7a\x52\x03\xa6\x04\x61\xec\x2d\x87\x3c\xf8\xd7\x9b\x65\x66\x1d\x7b\xe0\x0e\x01\x7b\x1c\xd0\x71\x00\xee\x14\x83\x22\x04\xa5\x35\x8e\x8c\x06\x0e\xe7\x89\xd1\xda\x13\x3a\x68\x8e\x4e\xb3\xf5\x0e\x94\x33\xd3\x98\x30\x70\x25\xeb\x1a\xfe\x78\xee\x80\x90\x95\x75\x13\xe0\xc9\xb6\xd6\xa9\x1e\x3c\x19\xa4\x4a\x66\x25\x14\x31\x56\x7b\x7d\x5a\xd2\xe7\xcf\xcb\x79\xc4\x94\x36\x9f\x9b\x16\xcd\x9c\x93\xc9\xbf\xa9\x5d\xf1\x83\xf7\xfd\x06\x8a\x41\xb1\xee\xd0\x94\x53\x72\x16\xed\x91\x5f\x55\x1f\x32\x25\x4a\x71\x52\x04\x43\x28\x81\xd6\xa6\xb3\x5c\x8a\xc6\x13\x58\xf8\xb5\x03\x52\xae\x45\xb8\x5e\xa4\xba\xb9\x49\x94\x42\xd8\x06\x1a\x57\x7c\xc9\x79\xb3\xef\x53\x98\x10\x43\x80\x1d\xa8\x71\x44\x67\x8a\x1c\xfb\xad\x44\x0a\x91\x00\xfb\x80\xb3\x96\xae\xb4\xf4\x88\x56\xe6\x17\xe3\x16\x6c\x03\xd5\x7f\x1b\x74\xf5\xb7\x53\xd6\xe5\x76\x83\x2e\x81\x74\xae\x17\xe3\x05\x7d\x66\x4a\xe9\x67\x8c\xe8\x4c\x4a\xd7\xee\xe5\x43\xac\xec\x7a\xb3\x47\x8e\xb8\x19\xc0\x6e\xb9\xb5\x14\x84\x7c\x24\x77\xe7\xfb\x63\xf1\x1d\xf4\x7d\xe6\x8a\x91\x9e\x5b\xe5\xcb\xa4\x74\xb1\x5a\x9d\x63\xdc\x02\x3a\x93\x92\x4c\x1f\x03\x00\x45\xc8\x6b\xd4\xd0\x02\x00\x00")
+
+func partition_tmpl() ([]byte, error) {
+	return bindata_read(
+		_partition_tmpl,
+		"partition.tmpl",
+	)
+}
+
+var _put_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xce\xb1\xaa\xc3\x20\x14\xc6\xf1\x59\x9f\xe2\x8c\xb9\x10\xcc\x13\x64\xb8\x4b\x96\xcb\xbd\xc3\xa5\x5b\xe9\x20\xe6\x24\x15\x8d\x2d\x1a\x05\x11\xdf\xbd\x48\xa4\x4d\x86\x76\x3c\xf0\x3b\x1f\xff\xae\x83\x94\xd8\x1f\x5f\x30\x67\x70\xeb\xcd\xa2\x83\xf5\x8a\x30\xcb\x80\x06\x02\xd7\x1e\x41\x9a\x62\x06\x3d\x56\xe6\xcd\x88\x76\xa7\x14\xc6\x16\x16\xae\xa4\x99\x8f\x50\x4e\x60\x50\xa0\x73\xdc\x46\x46\x27\x6f\x04\x34\x29\xb1\x7f\x11\x2a\xd8\x8e\x53\xbc\x63\xce\x5f\xaf\x90\x46\x61\x2c\xd7\xaf\x74\x82\xfd\x60\xdc\x40\x5b\x73\x52\x62\xdf\x76\x7e\x3e\x51\x22\x27\xd8\xaf\xb2\x43\x43\xdf\x83\x91\x1a\x12\x25\xe4\x03\x2a\xf9\x58\xda\x06\x3d\xd6\x65\x4a\x32\x7d\xff\x72\x56\x18\x2f\xd0\x43\xe0\xda\x23\xcd\x8f\x01\x00\x58\xd4\x01\xc3\x47\x01\x00\x00")
+
+func put_tmpl() ([]byte, error) {
+	return bindata_read(
+		_put_tmpl,
+		"put.tmpl",
+	)
+}
+
+var _reduce_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x51\x3d\x6b\xc3\x30\x14\x9c\xad\x5f\x71\xa3\x03\x41\xde\x03\x1e\xba\x74\xec\x10\x42\x97\xd2\xc1\xb5\x9f\xdd\x07\xf2\x53\xd0\x87\x21\x08\xfd\xf7\xa2\xda\x49\xe3\xa1\x9b\xee\x4e\x77\xef\x9e\xd4\x34\x48\x49\xbf\x75\x33\xe5\x0c\x47\x21\x3a\xf1\x08\xdf\x04\x47\x3e\x9a\x00\x3b\xa2\xb7\xf3\x17\x0b\xcb\xf4\xcb\x93\xa1\x99\x24\xf8\xa2\xa4\xa4\x5f\xcd\xb0\x9a\x8f\x60\x81\x75\x03\xb9\x23\xa2\xbf\xdf\x9e\x78\x21\xc1\x18\xa5\x0f\x6c\x45\xab\xa6\xc1\xe5\x41\xb3\x70\xe0\xce\x60\xe9\x4c\x24\xb0\xdf\xe6\xd3\x00\xde\x67\x17\x8d\xe6\x6b\xb8\x69\x55\xa2\x50\xa7\xa4\xcf\xfd\xb2\x89\x2b\xb8\xdc\xae\x94\xf3\xe1\x6f\x9b\xba\xc4\x17\x78\xa6\xf0\xde\x19\x5f\x2a\x8e\x6b\x97\x7a\xcf\xa6\xa4\x5f\xdc\xf4\x14\xf0\xd0\xf6\x08\x49\x55\xdb\xb3\x9c\x5a\x94\x78\x55\x8d\xd6\x81\x71\x6a\xe1\x3a\x99\x08\xcf\xc5\xf4\x6e\x85\xa4\xaa\xbb\xb9\xc5\x28\xf5\x7a\x3e\xfe\xef\xf8\xe0\xcf\x83\xaa\x72\x19\x59\x7e\x05\x8e\x7c\x34\x41\xe5\x9f\x01\x00\x6e\xe8\xeb\x2e\xb2\x01\x00\x00")
+
+func reduce_tmpl() ([]byte, error) {
+	return bindata_read(
+		_reduce_tmpl,
+		"reduce.tmpl",
+	)
+}
+
+var _regexp_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x35\x00\xca\xff\x76\x61\x72\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x3d\x20\x72\x65\x67\x65\x78\x70\x2e\x4d\x75\x73\x74\x43\x6f\x6d\x70\x69\x6c\x65\x28\x7b\x7b\x2e\x4d\x69\x73\x63\x2e\x50\x61\x74\x74\x65\x72\x6e\x7d\x7d\x29\x03\x00\xad\xf0\xd4\xec\x35\x00\x00\x00")
+
+func regexp_tmpl() ([]byte, error) {
+	return bindata_read(
+		_regexp_tmpl,
+		"regexp.tmpl",
+	)
+}
+
+var _retain_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x92\x31\x6f\xb3\x30\x18\x84\x67\xfc\x2b\xee\xdb\x40\x89\xc8\x37\xa7\x65\xe8\xd2\xad\x1d\xa2\x6e\x51\x06\xc7\x79\x21\x6e\x89\x8d\x5e\x1c\xa4\x14\xf9\xbf\x57\x36\x44\xa5\x43\x90\x3a\x54\x5d\x2a\x31\x60\xf1\x70\xf7\x20\x6e\xb5\x42\xdf\xe7\xcf\xf2\x44\xde\x83\xe9\x64\x3b\x6a\xe1\x8e\x04\xaa\xe9\x44\xc6\xb5\xb0\x65\x20\x1e\xeb\xc3\x08\xb9\xa3\x74\x90\x4c\x60\x7a\x25\xe5\xe8\x80\xfd\x25\xbe\x51\xe9\x8e\x0c\xca\xb3\x51\x4e\x5b\xb3\x04\xd3\xb9\xd5\xa6\x82\x76\x2d\xf6\x52\xbd\x85\x7b\xc9\x2c\x2f\xb9\x08\x10\xd2\xbe\xcf\x37\xaa\x1b\x63\x87\xc3\xcb\xa5\x21\xef\xb3\x4f\xa7\xb4\x1c\x22\x03\xfc\xc0\xd5\xf5\xf9\xde\xda\x3a\x43\x2f\x12\xa6\xf6\x5c\x3b\xac\x0b\x4c\xd3\xf2\xa9\xf1\x76\xfd\x7f\x27\x92\xd2\x32\x74\xe0\x58\x9a\x8a\x6e\xd3\x21\x34\xd1\x25\x4a\x93\xde\x64\xb6\x7a\x17\xcb\x93\x6b\x7d\x01\xd9\x34\x64\x0e\xe9\x70\x5e\xce\xc8\xe8\x5d\x26\x92\xc4\x8b\x70\x75\x92\xf1\x4e\x6c\x31\xfd\xb8\x89\x6a\x4d\x66\x4c\xcc\xee\xa0\x71\x8f\x9a\x66\xac\x02\xb3\x58\x44\xaf\xb9\x7a\x14\xb1\x33\x0a\xdc\xe4\x50\x60\x28\x16\x5e\x88\x61\x23\x4f\xba\x55\xf9\x26\xfe\xf3\x6f\x4f\x45\x2a\x45\xcd\xcf\x4d\xe5\x8b\xda\xaf\x2d\xe6\xdf\xdf\x64\xc6\xc9\x7c\x0c\x00\x29\xa1\x8c\x7e\x55\x04\x00\x00")
+
+func retain_tmpl() ([]byte, error) {
+	return bindata_read(
+		_retain_tmpl,
+		"retain.tmpl",
+	)
+}
+
+var _search_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x94\x41\x8f\x9b\x30\x10\x85\xcf\xf0\x2b\xde\x5e\x56\x41\xa5\xa0\xbd\x46\xcd\xa1\x87\xae\x54\xa9\xad\xaa\xdd\xaa\x97\x55\x0e\x0e\x0c\x89\xbb\x8e\x9d\xda\xc6\x69\x8a\xf8\xef\x95\x31\xac\x88\x14\x48\x0f\x39\xe4\xc0\xc4\x7e\x6f\x98\xef\x0d\x79\x8e\x67\x62\xba\xd8\x41\x93\xad\xb5\x34\xb0\x3b\x02\x97\x25\xfd\x81\xaa\xe0\xc0\x65\x57\x31\x4a\x5b\x2a\x51\x28\x21\xa8\xb0\x5c\x49\x30\x59\xe2\xb8\x23\xbb\x23\x0d\x6e\xc1\x0d\x0e\x9a\x0c\x49\x9b\xc5\x79\x8e\xcf\xdd\x5d\x03\xa9\xec\x50\x4f\x47\xd2\xdc\xf8\xbb\x9a\xfc\xcd\xa3\xaa\x45\x89\x8d\x77\x35\xe4\x5d\xb2\xb8\xaa\x65\x81\x45\xd3\x64\x4f\x85\xfb\xc6\xf6\xd4\xb6\x08\x0f\x3f\x4e\x07\x6a\xdb\xa4\xef\x79\xe1\x7c\xf9\xa3\xde\x86\x72\xd3\xf0\x0a\xd9\x57\x6e\x8a\xec\xb1\x96\x45\xdb\xa6\x10\x64\x0c\xbc\xda\xc2\xf1\x14\xee\xd7\xd9\xf9\x04\x1b\xa5\x44\xd3\x90\x2c\xbd\xe6\x82\xfb\x1e\x7d\x29\x41\x13\x47\x1c\xcb\x55\xf7\xda\x59\x6f\x26\x48\x9e\xb5\x94\x35\x4d\xf6\x28\xca\xd0\x5f\x92\x06\x1b\x0e\x2e\x6d\x10\xf6\x22\x51\x98\x2a\xee\xfc\xcd\xae\xb3\x4f\x82\xf6\x5f\xc8\x98\xb6\x4d\xe2\xc8\xff\xfa\x13\x3c\x05\xc7\x07\xcc\x9b\xe0\xfe\x7e\x24\xf5\x93\x89\x9a\x06\xad\x36\xee\xc6\xde\x8d\xf0\x39\xc0\x0a\xf3\x34\x1e\x84\xb4\xea\x32\xc6\x14\xac\xb2\xa4\xc1\xe4\x09\xf4\xbb\x66\x02\x24\x68\x4f\xd2\x9a\x29\x0a\x9d\xf5\x77\xab\x87\x19\x8e\x2d\x6f\x0b\xe4\xd6\x10\x2e\xcc\x2d\x20\x98\x14\xc4\x0a\xec\x70\x20\x59\x4e\x7b\xa6\x70\x49\x1c\x15\xea\x70\x9a\x3e\xf3\xc2\xdf\x3d\x2c\xd7\x29\x66\x0e\x2c\xd7\x73\x7d\xbc\xf0\x35\x56\x70\x3d\xe4\x27\xda\x2b\x47\x3d\x64\xdd\x3d\x84\xb5\xad\xb8\x36\x76\x00\x08\xbb\x63\xdd\x5a\x06\xac\x56\xc1\xa1\xd2\x6a\x7f\x39\x07\x3e\x3c\x7e\xa3\x99\x34\x47\xd2\xe6\x6d\xb3\xfd\x7a\x13\x8e\xcc\x40\x49\xfa\xdf\x48\x8c\x1b\xbc\x6d\x24\x06\xa4\x3c\x85\x7a\xf5\xd9\x38\x1b\x59\x9f\x11\x37\x61\x32\xa8\xc4\x11\xaf\x70\xa7\x5e\xc7\xd9\xa8\x98\x30\x14\x47\xed\x75\x94\x57\x40\x7a\xd2\x49\x1c\xe5\x39\xfe\x92\x0e\x4b\xe7\x58\xc1\xfc\xb4\x07\x30\x46\xf5\x6c\x2c\x0a\x26\xfd\x97\x6f\xcb\xf4\x86\x6d\x69\xe0\x41\x65\x1c\x39\xa6\x83\xc2\x78\x1a\x73\x11\x99\xdf\x8c\xf7\x0f\x3e\x41\x5e\x70\x46\x03\x2b\x4c\xfe\xf7\xb2\xbc\x6a\xf0\xf6\x2d\xb3\xba\xa6\xb8\xfd\x37\x00\x14\x99\x3c\x69\x5b\x06\x00\x00")
+
+func search_tmpl() ([]byte, error) {
+	return bindata_read(
+		_search_tmpl,
+		"search.tmpl",
+	)
+}
+
 var _setter_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd2\xd7\x57\xa8\xae\xd6\xf3\x4b\xcc\x4d\xad\xad\x55\x28\x4e\x2d\x29\x56\x28\xc9\x48\x55\x48\xcf\x2c\x4b\xcd\x53\x28\x4b\xcc\x29\x4d\x55\x48\x2c\x06\xa9\x70\xcb\x49\x81\x28\xd2\xe3\xe5\x4a\x2b\xcd\x4b\x56\xd0\xa8\xae\xd6\x0b\x4a\x2e\x83\xea\x84\x70\x42\x2a\x0b\x52\x6b\x6b\x35\x11\x26\x82\x14\x39\x16\xa5\x23\x14\x39\x16\xa5\xc3\x15\xf1\x72\x71\x22\x9b\xa1\x87\x6c\x8b\x82\xad\x02\xb2\x56\x5e\xae\x5a\x40\x00\x00\x00\xff\xff\x26\x49\x6f\x22\xa9\x00\x00\x00")
 
 func setter_tmpl() ([]byte, error) {
@@ -97,7 +457,7 @@ func setter_tmpl() ([]byte, error) {
 	)
 }
 
-var _sort_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd2\xd7\x57\x08\xce\x2f\x2a\x51\xc8\x2c\x56\x48\x54\x48\xce\xcf\x2b\x4b\xcd\xcb\x4c\xcd\x4b\x4e\x55\xc8\x4d\x2d\xc9\xc8\x4f\xd1\xe3\xe5\x4a\x2b\xcd\x4b\x56\xd0\xa8\xae\xd6\x0b\x4a\x2e\xf3\x4b\xcc\x4d\xad\xad\x55\x80\x70\x42\x2a\x0b\x52\x6b\x6b\x35\xc1\xfa\x35\x34\x51\x04\x15\xaa\x79\xb9\x38\x8b\xf3\x8b\x4a\xf4\xc0\x92\xc8\x9a\x35\x79\xb9\x38\x8b\x52\x4b\x4a\x8b\xf2\x14\x90\x85\x79\xb9\x6a\x01\x01\x00\x00\xff\xff\xfa\xa0\x7b\xf0\x8b\x00\x00\x00")
+var _sort_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8f\xb1\x6e\xc3\x30\x0c\x44\xe7\xe8\x2b\x38\x5a\x8b\xfc\x1d\x1d\xda\xa1\xe9\x0f\x38\xf2\x05\x12\xa0\x88\x81\xc4\xa4\x28\x08\xfe\x7b\xa1\xba\x0d\xea\x2d\x13\x71\x24\xf8\xee\x6e\x9e\xe9\xc8\x4d\x28\x77\x5a\x28\x72\xbd\xa3\x66\xd4\x08\xba\x40\x12\xaf\xc1\x9d\x6f\x35\xd2\xa4\x1a\xde\xe3\xfd\x6d\xb9\xc0\x8c\x36\xf1\xf1\x75\x85\x99\xff\x79\x9f\xfc\x6e\x49\xea\x0e\x9d\x9b\x04\xd5\x7c\xa6\xf0\x9a\x7b\x0c\x47\x59\x4e\x05\x66\xdb\x54\x45\xe9\x43\x71\x13\x55\xd4\xd5\x6c\xe7\xe1\xdd\xa1\x41\x6e\xad\xfe\x72\x37\x67\x67\xce\xcd\x33\xbd\xf4\xe1\x89\x95\x1a\xae\xdc\xa4\xd3\x67\x82\x24\x34\x92\x04\x8a\x5c\x0a\xa2\x64\xae\xa3\xd3\x48\x81\x67\x5a\xfc\x31\x27\x4f\x27\xe6\x42\xfa\x08\x30\x10\xe1\x71\xfe\x8f\xf0\xce\xbe\x07\x00\x7f\xa7\x77\x24\x3f\x01\x00\x00")
 
 func sort_tmpl() ([]byte, error) {
 	return bindata_read(
@@ -106,7 +466,7 @@ func sort_tmpl() ([]byte, error) {
 	)
 }
 
-var _sort_func_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xbd\x6e\xc3\x20\x14\x85\x77\x4b\x7e\x87\x33\xda\x92\x85\x77\x6f\xdd\xdb\x0e\x6d\x5f\xa0\x45\xb7\x0e\x11\x81\x88\x8b\x91\x22\x74\xdf\x3d\x02\x3b\x89\xcd\xc0\xcf\xe5\xfb\x8e\xce\x38\xe2\xdb\x87\x08\xf6\x21\x32\xe2\x89\xa0\xbd\xb5\xa4\xa3\xf1\x0e\x0b\x1b\x37\xd7\xe1\x6c\x12\x39\x58\x62\xc6\xff\xe2\xea\xaf\x6a\x9b\x72\x45\x97\xb3\xfa\xd2\xe9\xf3\xf7\x42\x22\x58\x1f\x3f\xb7\x2b\x89\xf4\x35\xba\x7b\x5a\x5d\x32\x03\xd2\xb9\x30\x6f\x61\x7e\x30\x7f\xde\xdb\xfe\xe0\x21\xb7\x0d\x80\xda\x49\xd5\x88\x9c\xd5\x87\x61\xad\xde\x89\x99\x82\xc8\x06\x94\xb5\x17\x27\xec\xbb\x0c\x2f\xa8\x54\x98\xea\xbe\x0d\xa5\x5f\xcf\x40\x71\x09\xee\xa0\xb5\x8d\xdc\x03\x00\x00\xff\xff\xcb\x9f\xa2\xc2\x15\x01\x00\x00")
+var _sort_func_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x50\xc1\x6a\xc3\x30\x0c\xbd\xe7\x2b\x74\x4c\xa0\x38\xf7\xde\x76\x1c\x6c\x3b\xac\xfb\x81\xd4\x51\x13\x0f\xcf\x2e\x96\x93\x31\x84\xfe\x7d\x58\x5e\x43\xbb\xd3\x60\x25\x10\x59\xf2\xd3\xf3\x7b\xaf\xef\xe1\x10\x53\x06\x8a\x29\x13\xe4\x19\xc1\x46\xef\xd1\x66\x17\x03\x2c\xe4\xc2\xa4\xc3\xc9\xad\x18\xc0\x23\x11\x9c\x96\xa0\xb7\xa6\x29\x27\x68\x99\xcd\xab\x5d\x5f\x86\x0f\x14\x81\xda\xbc\x7d\x9d\x51\xa4\x53\xe6\x76\x5b\x6a\x57\xb7\x83\xf5\xbd\x60\x1e\xd2\x74\xc1\x1c\x63\xf4\xdd\xcd\x1e\x70\x03\x00\xaa\xc8\x30\xbb\x13\x98\x67\x47\xd6\x1c\xf2\x70\xf4\x28\x52\x2b\x33\x7a\x2a\x5d\x4c\x99\x19\xc3\x28\x52\x94\x28\xf2\x09\x89\x30\x89\x54\x9e\xf2\x5d\xd3\xef\x7f\xba\xaa\x78\xb7\x61\x8a\xce\xbd\x5a\xac\x33\xe9\xb4\x24\xcc\x4b\x0a\x37\x3b\x8d\x34\x4d\xdf\xc3\x23\x15\x7f\x38\x42\xc2\xb3\xa6\xf7\x39\x63\x9e\x31\xfd\x4e\xd1\x91\x7a\xc1\x11\x06\x6b\x63\x1a\x35\xd3\xf8\x8f\x58\x2f\x0f\xff\x2d\xda\xf2\x07\xbe\xf6\x52\xd4\x98\x8d\xe4\xee\xa9\xc9\xf7\x00\x4c\xaa\x0b\x74\x54\x02\x00\x00")
 
 func sort_func_tmpl() ([]byte, error) {
 	return bindata_read(
@@ -124,6 +484,24 @@ func stringer_tmpl() ([]byte, error) {
 	)
 }
 
+var _sum_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8f\xbd\x8a\xc3\x30\x10\x84\x6b\xe9\x29\xa6\xf4\x71\x87\x7c\x75\xc0\x45\x9a\x94\x29\x42\xba\x90\xc2\x38\x2b\x47\x60\xc9\x46\x3f\x06\xb3\xe8\xdd\x83\xad\x40\xdc\xa4\x9b\x91\x66\xbf\xd9\xad\x6b\x30\xab\x73\x6b\x29\x67\x78\x8a\xc9\xbb\x80\xf8\x24\x84\x64\x31\xea\x4d\xd2\x40\x96\x5c\x0c\xab\x67\x56\xa7\xe1\x51\xf2\x7f\x18\x3d\xfe\x61\x34\x4c\x84\x09\x20\x3b\xc5\x45\x49\x9d\x5c\x87\x8a\x59\x5d\xba\xf9\x0d\x2e\xe6\xba\x4c\x94\xf3\xcf\xa7\xb0\xda\xf4\xd1\xf7\xe5\x07\x2c\xc5\xdc\xfa\xad\x7a\xff\x2e\x85\x1e\x3d\x0c\x0e\x0d\x7c\xeb\x7a\xc2\x9e\xad\xf6\x1b\xad\x04\xb1\x8e\xff\x36\xdf\x43\x37\x73\x97\x22\x4b\x51\xae\x45\x48\x56\xe6\xd7\x00\xe5\xff\x10\x56\x07\x01\x00\x00")
+
+func sum_tmpl() ([]byte, error) {
+	return bindata_read(
+		_sum_tmpl,
+		"sum.tmpl",
+	)
+}
+
+var _type_builder_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x74\x00\x8b\xff\x2f\x2f\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x62\x75\x69\x6c\x64\x73\x20\x61\x20\x7b\x7b\x2e\x45\x6d\x62\x65\x64\x7d\x7d\x20\x6f\x6e\x65\x20\x66\x69\x65\x6c\x64\x20\x61\x74\x20\x61\x20\x74\x69\x6d\x65\x2e\x0a\x74\x79\x70\x65\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x73\x74\x72\x75\x63\x74\x20\x7b\x0a\x09\x76\x20\x20\x20\x7b\x7b\x2e\x45\x6d\x62\x65\x64\x7d\x7d\x0a\x09\x73\x65\x74\x20\x6d\x61\x70\x5b\x73\x74\x72\x69\x6e\x67\x5d\x62\x6f\x6f\x6c\x0a\x7d\x03\x00\x17\x27\x16\xc9\x74\x00\x00\x00")
+
+func type_builder_tmpl() ([]byte, error) {
+	return bindata_read(
+		_type_builder_tmpl,
+		"type_builder.tmpl",
+	)
+}
+
 var _type_lesser_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2a\xa9\x2c\x48\x55\xa8\xae\xd6\xf3\x4b\xcc\x4d\xad\xad\x55\x28\x2e\x29\x2a\x4d\x2e\x51\xa8\xe6\xe5\xe2\xac\xae\xd6\x73\xcd\x4d\x4a\x4d\xa9\xad\xe5\xe5\xe2\xcc\x49\x2d\x2e\x56\x48\x2b\xcd\x4b\xd6\x28\xcb\xd4\x51\x28\xcb\x02\x69\xf1\xcd\x2c\x4e\xd6\x73\xcd\x49\xcd\x0d\xa9\x2c\x48\xad\xad\xd5\x54\x48\xca\xcf\xcf\xe1\xe5\xaa\x05\x04\x00\x00\xff\xff\xcb\x61\x5d\x2b\x53\x00\x00\x00")
 
 func type_lesser_tmpl() ([]byte, error) {
@@ -133,6 +511,33 @@ func type_lesser_tmpl() ([]byte, error) {
 	)
 }
 
+var _type_option_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x63\x00\x9c\xff\x2f\x2f\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x65\x73\x20\x61\x20\x7b\x7b\x2e\x45\x6d\x62\x65\x64\x7d\x7d\x20\x63\x72\x65\x61\x74\x65\x64\x20\x62\x79\x20\x4e\x65\x77\x7b\x7b\x2e\x4d\x69\x73\x63\x2e\x42\x61\x73\x65\x7d\x7d\x2e\x0a\x74\x79\x70\x65\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x66\x75\x6e\x63\x28\x2a\x7b\x7b\x2e\x45\x6d\x62\x65\x64\x7d\x7d\x29\x03\x00\x40\x0a\x9a\xb2\x63\x00\x00\x00")
+
+func type_option_tmpl() ([]byte, error) {
+	return bindata_read(
+		_type_option_tmpl,
+		"type_option.tmpl",
+	)
+}
+
+var _validate_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xc1\x4a\xc4\x50\x0c\x45\xd7\xf3\xbe\xe2\x2e\x5b\x90\xd7\x8f\x70\x27\xe8\x62\x10\x37\xe2\x22\x74\x52\x1a\xad\x89\x24\x99\x0e\x43\x79\xff\x2e\x1d\x45\x70\x79\x2e\x17\xce\x19\x06\xbc\xd0\x22\x27\x4a\x06\x69\x5c\xd8\x03\x97\x99\x73\x66\xc7\xb6\xd5\xe3\xb8\x3e\xd1\x27\xb7\x86\xa0\x94\x98\x84\x03\x39\x33\x46\xd3\x48\x27\xd1\x0c\xd8\x04\xc9\xc0\x24\xbc\x9c\xa2\x96\x61\xc0\x91\xf3\xec\x1a\x20\x05\xbb\x9b\x23\x67\x4a\xbc\x9b\x68\x80\x57\xf6\x2b\x56\xb1\x85\x52\x4c\xef\x60\x0e\x95\x05\x32\x61\x97\x32\xc8\x19\x6a\xca\xb5\x4c\x67\x1d\xd1\xfd\xab\xf8\x81\xe7\xeb\x17\xb7\xd6\xff\x95\x77\xfd\xaf\x67\x2b\x87\x95\x7c\x87\xc0\xeb\xdb\x6d\x2b\x87\x6d\xab\x8f\x12\x63\xbd\x9f\x79\xfc\x88\xd6\xca\xc1\x6f\x7d\xfb\xcd\x3c\xea\x83\x89\x76\xec\x1e\xb5\xd6\xbe\xb4\xef\x01\x00\x37\x0d\x08\x20\x12\x01\x00\x00")
+
+func validate_tmpl() ([]byte, error) {
+	return bindata_read(
+		_validate_tmpl,
+		"validate.tmpl",
+	)
+}
+
+var _values_tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x52\xbd\x6a\xc3\x30\x10\x9e\xad\xa7\xb8\xd1\x06\x57\xe9\x5c\x9a\xa1\x4b\x97\xd2\x0e\x4d\xb7\x60\x8a\x6a\x9d\x13\x25\x8a\x64\x24\x3b\x60\x0e\xbd\x7b\x91\x25\xd2\x98\x12\x68\x27\xfb\xb8\xef\xbe\x9f\x3b\xad\x56\x40\xc4\xdf\xc4\x09\x43\x00\x87\xc3\xe8\x8c\x87\x61\x8f\x70\x16\x7a\x44\x0f\xb6\x8b\xfd\x67\x2d\x13\x84\x48\x75\xc0\x5f\x95\x6f\xf9\xc6\xba\x01\x65\x08\xa0\x0c\x08\xdf\xa2\x91\xca\xec\xc0\x3a\x89\x2e\x4e\x0d\x7b\x54\x0e\x8e\x38\x79\x22\xd4\x3e\xd2\x2b\x03\xa3\xf1\x3d\xb6\xaa\x53\x28\x13\x94\x08\x8d\x0c\x81\xb3\x6e\x34\x2d\x94\x44\xfc\xbd\x3d\x67\x3b\xa9\xf8\x98\x7a\x0c\xa1\xfa\xb1\x59\x56\xb0\x6d\x88\xf8\x93\xdb\xa5\x1e\x10\x2b\xb2\xdd\x87\x35\x9c\xc4\x11\xcb\x25\xa0\x86\xfb\x1a\x34\x9a\x05\x3d\xbf\xce\x55\x55\xac\x20\xba\x83\x5f\xe9\x58\x11\x23\x2c\x79\x67\xc0\x0b\x4e\xff\x21\xef\xec\xbc\x8c\x48\xe4\x84\xd9\x21\xdc\x44\xc7\x34\x49\x74\x0d\xa2\xef\xd1\xc8\x32\x56\x75\x1c\xaf\x58\x11\x58\xe1\xad\x1b\xf8\x46\xab\x16\x73\x27\xee\xae\x54\x35\x1c\x40\x99\xa1\x82\x2f\x6b\x35\x50\xbe\x66\x1c\xf3\x5b\xd5\xc0\x63\xfa\x3b\x34\x10\xb2\x9f\xcf\x7a\x69\x29\xf6\x67\xf5\xbc\xcc\x8b\x7e\xaa\xeb\xdb\x9e\xb7\x47\x9c\x9a\x64\x2e\x6e\x31\xdd\xfb\x22\x32\x8f\xff\x35\xf9\x2d\xed\xf9\x7b\x25\x11\x5f\x0d\x2b\x72\xc6\xb3\xd0\x23\x7a\x16\xbe\x07\x00\x21\xce\xc0\xde\xce\x02\x00\x00")
+
+func values_tmpl() ([]byte, error) {
+	return bindata_read(
+		_values_tmpl,
+		"values.tmpl",
+	)
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -155,18 +560,63 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() ([]byte, error){
+	"all.tmpl": all_tmpl,
+	"any.tmpl": any_tmpl,
+	"avg.tmpl": avg_tmpl,
+	"builder_build.tmpl": builder_build_tmpl,
+	"builder_field.tmpl": builder_field_tmpl,
+	"builder_new.tmpl": builder_new_tmpl,
+	"chunk.tmpl": chunk_tmpl,
+	"clone.tmpl": clone_tmpl,
+	"compare.tmpl": compare_tmpl,
+	"contains.tmpl": contains_tmpl,
+	"count.tmpl": count_tmpl,
+	"delete.tmpl": delete_tmpl,
+	"distinct.tmpl": distinct_tmpl,
+	"elems.tmpl": elems_tmpl,
 	"equal.tmpl": equal_tmpl,
 	"filter.tmpl": filter_tmpl,
+	"filter_err.tmpl": filter_err_tmpl,
+	"filter_inplace.tmpl": filter_inplace_tmpl,
+	"filter_map.tmpl": filter_map_tmpl,
+	"filter_parallel.tmpl": filter_parallel_tmpl,
+	"find.tmpl": find_tmpl,
 	"getter.tmpl": getter_tmpl,
+	"groupby.tmpl": groupby_tmpl,
+	"hash.tmpl": hash_tmpl,
+	"heap.tmpl": heap_tmpl,
+	"index.tmpl": index_tmpl,
+	"index_by.tmpl": index_by_tmpl,
+	"iter.tmpl": iter_tmpl,
+	"iter_filter.tmpl": iter_filter_tmpl,
+	"iter_map.tmpl": iter_map_tmpl,
+	"keys.tmpl": keys_tmpl,
 	"len_swap.tmpl": len_swap_tmpl,
 	"less.tmpl": less_tmpl,
+	"lookup.tmpl": lookup_tmpl,
 	"mapper.tmpl": mapper_tmpl,
+	"mapper_err.tmpl": mapper_err_tmpl,
+	"mapper_map.tmpl": mapper_map_tmpl,
+	"mapper_parallel.tmpl": mapper_parallel_tmpl,
+	"min_max.tmpl": min_max_tmpl,
 	"new.tmpl": new_tmpl,
+	"option.tmpl": option_tmpl,
+	"partition.tmpl": partition_tmpl,
+	"put.tmpl": put_tmpl,
+	"reduce.tmpl": reduce_tmpl,
+	"regexp.tmpl": regexp_tmpl,
+	"retain.tmpl": retain_tmpl,
+	"search.tmpl": search_tmpl,
 	"setter.tmpl": setter_tmpl,
 	"sort.tmpl": sort_tmpl,
 	"sort_func.tmpl": sort_func_tmpl,
 	"stringer.tmpl": stringer_tmpl,
+	"sum.tmpl": sum_tmpl,
+	"type_builder.tmpl": type_builder_tmpl,
 	"type_lesser.tmpl": type_lesser_tmpl,
+	"type_option.tmpl": type_option_tmpl,
+	"validate.tmpl": validate_tmpl,
+	"values.tmpl": values_tmpl,
 }
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
@@ -208,20 +658,100 @@ type _bintree_t struct {
 	Children map[string]*_bintree_t
 }
 var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
+	"all.tmpl": &_bintree_t{all_tmpl, map[string]*_bintree_t{
+	}},
+	"any.tmpl": &_bintree_t{any_tmpl, map[string]*_bintree_t{
+	}},
+	"avg.tmpl": &_bintree_t{avg_tmpl, map[string]*_bintree_t{
+	}},
+	"builder_build.tmpl": &_bintree_t{builder_build_tmpl, map[string]*_bintree_t{
+	}},
+	"builder_field.tmpl": &_bintree_t{builder_field_tmpl, map[string]*_bintree_t{
+	}},
+	"builder_new.tmpl": &_bintree_t{builder_new_tmpl, map[string]*_bintree_t{
+	}},
+	"chunk.tmpl": &_bintree_t{chunk_tmpl, map[string]*_bintree_t{
+	}},
+	"clone.tmpl": &_bintree_t{clone_tmpl, map[string]*_bintree_t{
+	}},
+	"compare.tmpl": &_bintree_t{compare_tmpl, map[string]*_bintree_t{
+	}},
+	"contains.tmpl": &_bintree_t{contains_tmpl, map[string]*_bintree_t{
+	}},
+	"count.tmpl": &_bintree_t{count_tmpl, map[string]*_bintree_t{
+	}},
+	"delete.tmpl": &_bintree_t{delete_tmpl, map[string]*_bintree_t{
+	}},
+	"distinct.tmpl": &_bintree_t{distinct_tmpl, map[string]*_bintree_t{
+	}},
+	"elems.tmpl": &_bintree_t{elems_tmpl, map[string]*_bintree_t{
+	}},
 	"equal.tmpl": &_bintree_t{equal_tmpl, map[string]*_bintree_t{
 	}},
 	"filter.tmpl": &_bintree_t{filter_tmpl, map[string]*_bintree_t{
 	}},
+	"filter_err.tmpl": &_bintree_t{filter_err_tmpl, map[string]*_bintree_t{
+	}},
+	"filter_inplace.tmpl": &_bintree_t{filter_inplace_tmpl, map[string]*_bintree_t{
+	}},
+	"filter_map.tmpl": &_bintree_t{filter_map_tmpl, map[string]*_bintree_t{
+	}},
+	"filter_parallel.tmpl": &_bintree_t{filter_parallel_tmpl, map[string]*_bintree_t{
+	}},
+	"find.tmpl": &_bintree_t{find_tmpl, map[string]*_bintree_t{
+	}},
 	"getter.tmpl": &_bintree_t{getter_tmpl, map[string]*_bintree_t{
 	}},
+	"groupby.tmpl": &_bintree_t{groupby_tmpl, map[string]*_bintree_t{
+	}},
+	"hash.tmpl": &_bintree_t{hash_tmpl, map[string]*_bintree_t{
+	}},
+	"heap.tmpl": &_bintree_t{heap_tmpl, map[string]*_bintree_t{
+	}},
+	"index.tmpl": &_bintree_t{index_tmpl, map[string]*_bintree_t{
+	}},
+	"index_by.tmpl": &_bintree_t{index_by_tmpl, map[string]*_bintree_t{
+	}},
+	"iter.tmpl": &_bintree_t{iter_tmpl, map[string]*_bintree_t{
+	}},
+	"iter_filter.tmpl": &_bintree_t{iter_filter_tmpl, map[string]*_bintree_t{
+	}},
+	"iter_map.tmpl": &_bintree_t{iter_map_tmpl, map[string]*_bintree_t{
+	}},
+	"keys.tmpl": &_bintree_t{keys_tmpl, map[string]*_bintree_t{
+	}},
 	"len_swap.tmpl": &_bintree_t{len_swap_tmpl, map[string]*_bintree_t{
 	}},
 	"less.tmpl": &_bintree_t{less_tmpl, map[string]*_bintree_t{
 	}},
+	"lookup.tmpl": &_bintree_t{lookup_tmpl, map[string]*_bintree_t{
+	}},
 	"mapper.tmpl": &_bintree_t{mapper_tmpl, map[string]*_bintree_t{
 	}},
+	"mapper_err.tmpl": &_bintree_t{mapper_err_tmpl, map[string]*_bintree_t{
+	}},
+	"mapper_map.tmpl": &_bintree_t{mapper_map_tmpl, map[string]*_bintree_t{
+	}},
+	"mapper_parallel.tmpl": &_bintree_t{mapper_parallel_tmpl, map[string]*_bintree_t{
+	}},
+	"min_max.tmpl": &_bintree_t{min_max_tmpl, map[string]*_bintree_t{
+	}},
 	"new.tmpl": &_bintree_t{new_tmpl, map[string]*_bintree_t{
 	}},
+	"option.tmpl": &_bintree_t{option_tmpl, map[string]*_bintree_t{
+	}},
+	"partition.tmpl": &_bintree_t{partition_tmpl, map[string]*_bintree_t{
+	}},
+	"put.tmpl": &_bintree_t{put_tmpl, map[string]*_bintree_t{
+	}},
+	"reduce.tmpl": &_bintree_t{reduce_tmpl, map[string]*_bintree_t{
+	}},
+	"regexp.tmpl": &_bintree_t{regexp_tmpl, map[string]*_bintree_t{
+	}},
+	"retain.tmpl": &_bintree_t{retain_tmpl, map[string]*_bintree_t{
+	}},
+	"search.tmpl": &_bintree_t{search_tmpl, map[string]*_bintree_t{
+	}},
 	"setter.tmpl": &_bintree_t{setter_tmpl, map[string]*_bintree_t{
 	}},
 	"sort.tmpl": &_bintree_t{sort_tmpl, map[string]*_bintree_t{
@@ -230,6 +760,16 @@ var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
 	}},
 	"stringer.tmpl": &_bintree_t{stringer_tmpl, map[string]*_bintree_t{
 	}},
+	"sum.tmpl": &_bintree_t{sum_tmpl, map[string]*_bintree_t{
+	}},
+	"type_builder.tmpl": &_bintree_t{type_builder_tmpl, map[string]*_bintree_t{
+	}},
 	"type_lesser.tmpl": &_bintree_t{type_lesser_tmpl, map[string]*_bintree_t{
 	}},
+	"type_option.tmpl": &_bintree_t{type_option_tmpl, map[string]*_bintree_t{
+	}},
+	"validate.tmpl": &_bintree_t{validate_tmpl, map[string]*_bintree_t{
+	}},
+	"values.tmpl": &_bintree_t{values_tmpl, map[string]*_bintree_t{
+	}},
 }}